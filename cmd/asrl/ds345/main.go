@@ -13,6 +13,8 @@ import (
 
 	"github.com/gotmc/asrl"
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/srs/ds345"
 )
@@ -40,13 +42,21 @@ func init() {
 
 func main() {
 	// Parse the flags
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	ctx := context.Background()
 
-	// Open the serial port.
 	address := fmt.Sprintf("ASRL::%s::%d::8N2::INSTR", serialPort, baudRate)
 	log.Printf("VISA Address = %s", address)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Open the serial port.
 	dev, err := asrl.NewDevice(ctx, address)
 	if err != nil {
 		log.Fatal(err)
@@ -107,19 +117,40 @@ func main() {
 	if err != nil {
 		log.Fatalf("error getting channel 0: %s", err)
 	}
-	if err = ch.DisableOutput(); err != nil && err != ivi.ErrFunctionNotSupported {
+
+	// The DS345 has no SCPI error queue, so read its standard event and DDS
+	// status registers after each configuration step to report any commands
+	// it rejected.
+	chk := errqueue.NewChecker(dev, errqueue.DS345)
+
+	// The DS345 has no output switch, so ignore the driver reporting that
+	// enabling and disabling the output isn't supported.
+	if err = chk.Step(ctx, "disable output", func() error {
+		if err := ch.DisableOutput(); err != ivi.ErrFunctionNotSupported {
+			return err
+		}
+		return nil
+	}); err != nil {
 		log.Fatalf("error disabling output on ch0: %s", err)
 	}
-	if err = ch.SetAmplitude(0.5); err != nil {
+	if err = chk.Step(ctx, "set amplitude", func() error {
+		return ch.SetAmplitude(0.5)
+	}); err != nil {
 		log.Fatalf("error setting the amplitude on ch0: %s", err)
 	}
-	if err = ch.SetStandardWaveform(fgen.Sine); err != nil {
+	if err = chk.Step(ctx, "set standard waveform", func() error {
+		return ch.SetStandardWaveform(fgen.Sine)
+	}); err != nil {
 		log.Fatalf("error setting the standard waveform: %s", err)
 	}
-	if err = ch.SetDCOffset(0.2); err != nil {
+	if err = chk.Step(ctx, "set DC offset", func() error {
+		return ch.SetDCOffset(0.2)
+	}); err != nil {
 		log.Fatalf("error setting DC offest: %s", err)
 	}
-	if err = ch.SetFrequency(2350); err != nil {
+	if err = chk.Step(ctx, "set frequency", func() error {
+		return ch.SetFrequency(2350)
+	}); err != nil {
 		log.Fatalf("error setting frequency: %s", err)
 	}
 
@@ -127,29 +158,44 @@ func main() {
 	// standard waveform can be configured using a single method. In this case, a
 	// Sine wave with 0.5 Vpp amplitude, 0.0 Vdc offset, 100.0 Hz, and 0.0 phase
 	// shift is created.
-	if err = ch.ConfigureStandardWaveform(fgen.Sine, 0.5, 0.0, 100, 0); err != nil {
+	if err = chk.Step(ctx, "configure standard waveform", func() error {
+		return ch.ConfigureStandardWaveform(fgen.Sine, 0.5, 0.0, 100, 0)
+	}); err != nil {
 		log.Fatalf("error configuring standard waveform: %s", err)
 	}
 
 	// Configure a burst waveform using the above 100 Hz sine wave with 400 ms
 	// on-time and 200 ms off-time for a total period of 600 ms.
-	if err = ch.SetOperationMode(fgen.BurstMode); err != nil {
+	if err = chk.Step(ctx, "set burst mode", func() error {
+		return ch.SetOperationMode(fgen.BurstMode)
+	}); err != nil {
 		log.Fatalf("error setting burst mode: %s", err)
 	}
 
-	if err = ch.SetBurstCount(4); err != nil {
+	if err = chk.Step(ctx, "set burst count", func() error {
+		return ch.SetBurstCount(4)
+	}); err != nil {
 		log.Fatalf("error setting burst count: %s", err)
 	}
 
-	if err = ch.SetStartTriggerSource(fgen.TriggerSourceInternal); err != nil {
+	if err = chk.Step(ctx, "set internal trigger source", func() error {
+		return ch.SetStartTriggerSource(fgen.TriggerSourceInternal)
+	}); err != nil {
 		log.Fatalf("error setting internal trigger source: %s", err)
 	}
 
-	if err = ch.SetInternalTriggerRate(1 / 0.06); err != nil {
+	if err = chk.Step(ctx, "set internal trigger rate", func() error {
+		return ch.SetInternalTriggerRate(1 / 0.06)
+	}); err != nil {
 		log.Fatalf("error setting internal trigger rate: %s", err)
 	}
 
-	if err = ch.EnableOutput(); err != nil && err != ivi.ErrFunctionNotSupported {
+	if err = chk.Step(ctx, "enable output", func() error {
+		if err := ch.EnableOutput(); err != ivi.ErrFunctionNotSupported {
+			return err
+		}
+		return nil
+	}); err != nil {
 		log.Fatalf("error enabling output: %s", err)
 	}
 
@@ -8,6 +8,7 @@ package main
 import (
 	"bufio"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/gotmc/asrl"
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
 )
 
@@ -56,13 +59,23 @@ func bounded() (context.Context, context.CancelFunc) {
 
 func main() {
 	// Parse the flags
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
-	// Open the serial port, bounded by -timeout so an unresponsive adapter
-	// fails fast instead of hanging.
+	ctx := context.Background()
+
 	address := fmt.Sprintf("ASRL::%s::%d::8N2::INSTR", serialPort, baudRate)
 	log.Printf("VISA Address = %s", address)
 	log.Printf("I/O timeout = %s", timeout)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Open the serial port, bounded by -timeout so an unresponsive adapter
+	// fails fast instead of hanging.
 	openCtx, openCancel := bounded()
 	dev, err := asrl.NewDevice(openCtx, address, asrl.WithHWHandshaking(true))
 	openCancel()
@@ -115,25 +128,33 @@ func main() {
 		log.Print(err)
 	}
 
+	// Drain the SYST:ERR? queue after each configuration step so settings the
+	// E3631A rejected are logged rather than silently ignored.
+	chk := errqueue.NewChecker(dev, errqueue.SCPI, errqueue.WithTimeout(timeout))
+
 	// Set the output voltage
 	desiredVoltage := 5.0
 	log.Printf("Set the voltage to %.2f Vdc", desiredVoltage)
-	err = ch6v.SetVoltageLevel(desiredVoltage)
-	if err != nil && err != io.EOF {
+	err = chk.Step(ctx, "set voltage level", func() error {
+		return ch6v.SetVoltageLevel(desiredVoltage)
+	})
+	if err != nil && !errors.Is(err, io.EOF) {
 		log.Print(err)
 	}
 
 	// Set the current limit
 	desiredCurrent := 1.0
 	log.Printf("Set the current limit to %.2f Adc", desiredCurrent)
-	err = ch6v.SetCurrentLimit(desiredCurrent)
+	err = chk.Step(ctx, "set current limit", func() error {
+		return ch6v.SetCurrentLimit(desiredCurrent)
+	})
 	if err != nil {
 		log.Print(err)
 	}
 
 	// Enable the 6V output
 	log.Printf("Enable 6V output")
-	err = ch6v.EnableOutput()
+	err = chk.Step(ctx, "enable output", ch6v.EnableOutput)
 	if err != nil {
 		log.Print(err)
 	}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/broker"
+)
+
+func main() {
+	log.Println("IVI Bench Connection Broker Application")
+
+	var (
+		socket string
+		hold   time.Duration
+		idle   time.Duration
+		status bool
+	)
+	flag.StringVar(&socket, "socket", broker.DefaultSocket(), "Unix socket to listen on")
+	flag.DurationVar(&hold, "hold", broker.DefaultHold, "Time a session keeps its device after a request")
+	flag.DurationVar(
+		&idle,
+		"idle",
+		broker.DefaultIdle,
+		"Time a device stays open after its last session (0 = until the broker stops)",
+	)
+	flag.BoolVar(&status, "status", false, "Print the devices open in a running broker and exit")
+	flag.Parse()
+
+	if status {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		devs, err := broker.Status(ctx, socket)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printStatus(os.Stdout, devs)
+		return
+	}
+	if err := run(socket, hold, idle); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(socket string, hold, idle time.Duration) error {
+	// The broker opens the devices itself rather than through another broker.
+	os.Unsetenv(broker.SocketEnv)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := removeStale(ctx, socket); err != nil {
+		return err
+	}
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", socket, err)
+	}
+
+	open := func(ctx context.Context, dev broker.Device) (ivi.Transport, error) {
+		var p *bench.PrologixConfig
+		if dev.Port != "" {
+			p = &bench.PrologixConfig{Port: dev.Port, GPIB: dev.GPIB}
+		}
+		return bench.OpenTransport(ctx, dev.Address, p)
+	}
+	srv := broker.NewServer(open, broker.WithHold(hold), broker.WithIdle(idle))
+	log.Printf("Listening on %s; set %s=%s to use the broker", socket, broker.SocketEnv, socket)
+	err = srv.Serve(ctx, l)
+	log.Println("Shutting down")
+	return err
+}
+
+// removeStale removes the socket file left by a broker that has exited. It
+// fails if a broker is still listening on it.
+func removeStale(ctx context.Context, socket string) error {
+	if _, err := os.Stat(socket); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", socket)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("a broker is already listening on %s", socket)
+	}
+	return os.Remove(socket)
+}
+
+// printStatus prints the devices open in a broker.
+func printStatus(w io.Writer, devs []broker.DeviceStatus) {
+	if len(devs) == 0 {
+		fmt.Fprintln(w, "No devices are open")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Device\tOpen for\tSessions\tIn use\tRequests\t")
+	for _, d := range devs {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%t\t%d\t\n",
+			d.Device, time.Since(d.Opened).Round(time.Second), d.Sessions, d.Held, d.Requests)
+	}
+	tw.Flush()
+}
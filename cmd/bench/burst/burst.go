@@ -0,0 +1,265 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+	"github.com/gotmc/ivi-examples/internal/scpi"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+)
+
+// minSamplesPerCycle is the lowest sample rate, relative to the signal
+// frequency, at which cycles are counted reliably.
+const minSamplesPerCycle = 20
+
+// burster is the part of a function generator channel that configures an
+// internally triggered burst. It isn't in the IVI fgen base class, but the
+// kt33000 channels implement it.
+type burster interface {
+	SetOperationMode(mode fgen.OperationMode) error
+	SetBurstCount(count int) error
+	SetStartTriggerSource(src fgen.TriggerSource) error
+	SetInternalTriggerRate(rate float64) error
+}
+
+// row is one captured burst.
+type row struct {
+	start  float64
+	cycles measure.Result
+	onTime measure.Result
+	period measure.Result // to the next burst; zero for the last one
+}
+
+// checker configures a burst on the generator and measures it on the scope.
+type checker struct {
+	gen       *bench.Instrument
+	genCh     int
+	scope     *bench.Instrument
+	scopeCh   int
+	amplitude float64 // Vpp into 50 Ω
+	frequency float64 // Hz
+	count     int     // cycles per burst
+	period    time.Duration
+	timeTol   float64 // % of on-time and period
+	points    int
+	settle    time.Duration
+	maxTime   time.Duration
+}
+
+// onTime returns the expected duration of a burst.
+func (c *checker) onTime() float64 { return float64(c.count) / c.frequency }
+
+// threshold returns the level above which the signal is taken as a cycle: a
+// quarter of the peak voltage, well clear of the noise on the idle level.
+func (c *checker) threshold() float64 { return c.amplitude / 8 }
+
+// holdoff returns the scope trigger holdoff. It must be longer than a burst
+// so only the first cycle of each burst triggers, and shorter than the
+// period, so it's set midway between the end of a burst and the next one.
+func (c *checker) holdoff() time.Duration {
+	on := time.Duration(c.onTime() * float64(time.Second))
+	return on + (c.period-on)/2
+}
+
+func (c *checker) genChannel(ctx context.Context, fn func(ch bench.FGenChannel) error) error {
+	return c.gen.Do(ctx, func(drv bench.Inherent) error {
+		ch, err := drv.(bench.FGen).Channel(c.genCh)
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+// setupGen outputs the sine in bursts of count cycles every period.
+func (c *checker) setupGen(ctx context.Context) error {
+	return c.genChannel(ctx, func(ch bench.FGenChannel) error {
+		b, ok := ch.(burster)
+		if !ok {
+			return fmt.Errorf("%s driver can't configure a burst", c.gen.Driver)
+		}
+		if err := ch.ConfigureStandardWaveform(fgen.Sine, c.amplitude, 0, c.frequency, 0); err != nil {
+			return err
+		}
+		if err := b.SetOperationMode(fgen.BurstMode); err != nil {
+			return err
+		}
+		if err := b.SetBurstCount(c.count); err != nil {
+			return err
+		}
+		if err := b.SetStartTriggerSource(fgen.TriggerSourceInternal); err != nil {
+			return err
+		}
+		if err := b.SetInternalTriggerRate(1 / c.period.Seconds()); err != nil {
+			return err
+		}
+		return ch.EnableOutput()
+	})
+}
+
+// setupScope terminates the scope input in 50 Ω, triggers on the first cycle
+// of each burst and sets the timebase to capture two whole bursts. The record
+// starts two cycles before the trigger so the first burst is complete.
+func (c *checker) setupScope(ctx context.Context) error {
+	return c.scope.Do(ctx, func(drv bench.Inherent) error {
+		sc, ok := bench.As[bench.ScopeTiming](drv)
+		if !ok {
+			return fmt.Errorf("%s driver can't set the timebase and trigger", c.scope.Driver)
+		}
+		ch, err := drv.(bench.Scope).Channel(c.scopeCh)
+		if err != nil {
+			return err
+		}
+		vert, ok := ch.(bench.ScopeVertical)
+		if !ok {
+			return fmt.Errorf("%s driver can't set the input impedance", c.scope.Driver)
+		}
+		if err := vert.SetInputImpedance(50.0); err != nil {
+			return err
+		}
+		// Use 5 of the 8 vertical divisions.
+		if err := vert.Configure(c.amplitude*8/5, 0, scope.DCVerticalCoupling, false, 1, true); err != nil {
+			return err
+		}
+		if err := sc.SetTriggerType(scope.EdgeTrigger); err != nil {
+			return err
+		}
+		// The IVI scope class has no trigger source, so use the InfiniiVision
+		// SCPI command.
+		cmd := fmt.Sprintf(":TRIGger:EDGE:SOURce CHANnel%d", c.scopeCh+1)
+		if err := c.scope.Transport().Command(ctx, cmd); err != nil {
+			return fmt.Errorf("error sending %q: %w", cmd, err)
+		}
+		if err := sc.SetTriggerLevel(c.threshold()); err != nil {
+			return err
+		}
+		if err := sc.SetTriggerHoldoff(c.holdoff()); err != nil {
+			return err
+		}
+		cycle := time.Duration(float64(time.Second) / c.frequency)
+		if err := sc.SetAcquisitionTimePerRecord(2*c.period + 4*cycle); err != nil {
+			return err
+		}
+		return sc.SetAcquisitionStartTime(-2 * cycle)
+	})
+}
+
+// capture takes a single acquisition and reads the channel's waveform.
+func (c *checker) capture(ctx context.Context) (waveform, error) {
+	var w waveform
+	err := c.scope.Do(ctx, func(bench.Inherent) error {
+		t := c.scope.Transport()
+		for _, cmd := range []string{
+			fmt.Sprintf(":WAVeform:SOURce CHANnel%d", c.scopeCh+1),
+			":WAVeform:FORMat BYTE",
+			":WAVeform:POINts:MODE MAXimum",
+			fmt.Sprintf(":WAVeform:POINts %d", c.points),
+			fmt.Sprintf(":DIGitize CHANnel%d", c.scopeCh+1),
+		} {
+			if err := t.Command(ctx, cmd); err != nil {
+				return fmt.Errorf("error sending %q: %w", cmd, err)
+			}
+		}
+		acqCtx, cancel := context.WithTimeout(ctx, c.maxTime)
+		defer cancel()
+		if _, err := t.Query(acqCtx, "*OPC?"); err != nil {
+			return fmt.Errorf("error waiting for the acquisition: %w", err)
+		}
+		pre, err := t.Query(ctx, ":WAVeform:PREamble?")
+		if err != nil {
+			return err
+		}
+		data, err := scpi.ReadBlock(ctx, t, ":WAVeform:DATA?")
+		if err != nil {
+			return err
+		}
+		w, err = parseWaveform(pre, data)
+		return err
+	})
+	return w, err
+}
+
+// run configures the burst, captures it and checks the cycles, on-time and
+// period of each complete burst, recording the results in p. The generator
+// output is turned off again even if ctx is cancelled.
+func (c *checker) run(ctx context.Context, p *report.Procedure) ([]row, error) {
+	if err := c.setupScope(ctx); err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", c.scope.Name, err)
+	}
+	if err := c.setupGen(ctx); err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", c.gen.Name, err)
+	}
+	defer func() {
+		err := c.genChannel(context.WithoutCancel(ctx), func(ch bench.FGenChannel) error {
+			return ch.DisableOutput()
+		})
+		if err != nil {
+			log.Printf("error turning off %s: %s", c.gen.Name, err)
+		}
+	}()
+
+	select {
+	case <-time.After(c.settle):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	w, err := c.capture(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error capturing on %s: %w", c.scope.Name, err)
+	}
+	if w.dt*c.frequency*minSamplesPerCycle > 1 {
+		return nil, fmt.Errorf("%s sample interval is too long for %s; raise -points",
+			measure.Format(w.dt, measure.Second, 3), measure.Format(c.frequency, measure.Hertz, 4))
+	}
+
+	// A burst ends once the signal has been idle for longer than a cycle.
+	var bursts []burst
+	for _, b := range w.bursts(c.threshold(), 1.5/c.frequency) {
+		if b.complete {
+			bursts = append(bursts, b)
+		}
+	}
+	if len(bursts) < 2 {
+		return nil, fmt.Errorf("captured %d complete bursts; need 2 to measure the period", len(bursts))
+	}
+
+	var rows []row
+	for i, b := range bursts {
+		name := fmt.Sprintf("burst %d", i+1)
+		r := row{start: b.start}
+		r.cycles = measure.Check(name+" cycles",
+			measure.Measurement{Value: float64(b.cycles), Unit: measure.Unitless},
+			measure.Range(float64(c.count), float64(c.count)))
+		// The signal is within the threshold for part of the first and last
+		// cycles of the sine, so add that back.
+		edge := math.Asin(min(c.threshold()/b.peak, 1)) / (2 * math.Pi * c.frequency)
+		r.onTime = measure.Check(name+" on-time",
+			measure.Measurement{Value: b.end - b.start + 2*edge, Unit: measure.Second},
+			measure.TolerancePercent(c.onTime(), c.timeTol))
+		log.Println(r.cycles)
+		log.Println(r.onTime)
+		p.RecordResult(r.cycles)
+		p.RecordResult(r.onTime)
+		if i+1 < len(bursts) {
+			r.period = measure.Check(name+" period",
+				measure.Measurement{Value: bursts[i+1].start - b.start, Unit: measure.Second},
+				measure.TolerancePercent(c.period.Seconds(), c.timeTol))
+			log.Println(r.period)
+			p.RecordResult(r.period)
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+)
+
+var errFailed = errors.New("burst check failed")
+
+func main() {
+	log.Println("IVI Bench Burst Timing Application")
+
+	var (
+		configFile string
+		genName    string
+		scopeName  string
+		htmlFile   string
+		junitFile  string
+		c          checker
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the function generator and scope",
+	)
+	flag.StringVar(&genName, "fgen", "fgen", "Name of the kt33000 generator in the bench config")
+	flag.IntVar(&c.genCh, "fgen-channel", 0, "0-based generator channel")
+	flag.StringVar(&scopeName, "scope", "scope", "Name of the infiniivision scope in the bench config")
+	flag.IntVar(&c.scopeCh, "scope-channel", 0, "0-based scope channel")
+	flag.Float64Var(&c.amplitude, "amplitude", 0.5, "Sine amplitude into 50 Ω (Vpp)")
+	flag.Float64Var(&c.frequency, "frequency", 100, "Sine frequency (Hz)")
+	flag.IntVar(&c.count, "count", 4, "Cycles per burst")
+	flag.DurationVar(&c.period, "period", 60*time.Millisecond, "Burst period (internal trigger)")
+	flag.Float64Var(&c.timeTol, "time-tol", 1, "On-time and period tolerance (%)")
+	flag.IntVar(&c.points, "points", 10000, "Waveform points to read from the scope")
+	flag.DurationVar(&c.settle, "settle", time.Second, "Settling time before the acquisition")
+	flag.DurationVar(&c.maxTime, "maxtime", 5*time.Second, "Maximum time for the scope acquisition")
+	flag.StringVar(
+		&htmlFile,
+		"html",
+		"burst.html",
+		"File to write the HTML report to (empty = none)",
+	)
+	flag.StringVar(
+		&junitFile,
+		"junit",
+		"",
+		"File to write a JUnit XML report to (empty = none)",
+	)
+	flag.Parse()
+
+	if c.amplitude <= 0 || c.frequency <= 0 || c.count < 1 || c.points < 1 {
+		log.Fatal("amplitude, frequency, count and points must be positive")
+	}
+	if c.onTime() >= c.period.Seconds() {
+		log.Fatalf("%d cycles at %g Hz don't fit in the %s burst period", c.count, c.frequency, c.period)
+	}
+	err := run(configFile, genName, scopeName, &c, htmlFile, junitFile)
+	if errors.Is(err, errFailed) {
+		log.Println("FAIL")
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("PASS")
+}
+
+func run(configFile, genName, scopeName string, c *checker, htmlFile, junitFile string) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	var ok bool
+	if c.gen, ok = b.Instrument(genName); !ok || c.gen.Class != bench.ClassFGen {
+		return fmt.Errorf("no function generator %q in the bench config", genName)
+	}
+	if c.scope, ok = b.Instrument(scopeName); !ok || c.scope.Class != bench.ClassScope {
+		return fmt.Errorf("no oscilloscope %q in the bench config", scopeName)
+	}
+
+	rep := report.New(fmt.Sprintf("%s burst timing", genName))
+	if rep.Instruments, err = report.Identify(openCtx, b); err != nil {
+		log.Printf("error identifying instruments: %s", err)
+	}
+	p := rep.Begin(fmt.Sprintf("%s %d × %s sine every %s on %s (holdoff %s)",
+		genName, c.count, measure.Format(c.frequency, measure.Hertz, 4), c.period, scopeName, c.holdoff()))
+	rows, err := c.run(ctx, p)
+	p.End(err)
+
+	printTable(os.Stdout, rep, rows)
+	if err := rep.WriteFiles(htmlFile, junitFile); err != nil {
+		return err
+	}
+	return rep.Err(errFailed)
+}
+
+// printTable prints the cycles, on-time and period of each burst.
+func printTable(w io.Writer, rep *report.Report, rows []row) {
+	fmt.Fprintf(w, "\n%s\n%s\n\n", rep.Procedures[0].Name, rep.Start.Format(time.RFC1123))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Burst\tStart\tCycles\tOn-time\tPeriod\tResult\t")
+	for i, r := range rows {
+		period := "-"
+		if r.period.Name != "" {
+			period = r.period.Measurement.String()
+		}
+		var mismatches []string
+		if !r.cycles.Pass {
+			mismatches = append(mismatches, fmt.Sprintf("cycles %s", r.cycles.Limit.Format(measure.Unitless)))
+		}
+		if !r.onTime.Pass {
+			mismatches = append(mismatches, fmt.Sprintf("on-time %s", r.onTime.Limit.Format(measure.Second)))
+		}
+		if r.period.Name != "" && !r.period.Pass {
+			mismatches = append(mismatches, fmt.Sprintf("period %s", r.period.Limit.Format(measure.Second)))
+		}
+		verdict := measure.Verdict(len(mismatches) == 0)
+		if len(mismatches) > 0 {
+			verdict += fmt.Sprintf(" (%s)", strings.Join(mismatches, ", "))
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t\n",
+			i+1,
+			measure.Format(r.start, measure.Second, 4),
+			r.cycles.Measurement,
+			r.onTime.Measurement,
+			period,
+			verdict,
+		)
+	}
+	tw.Flush()
+	rep.WriteSummary(w)
+}
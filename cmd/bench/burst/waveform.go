@@ -0,0 +1,102 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// waveform is a record captured by the scope. Sample i was taken at
+// t0 + i*dt seconds relative to the trigger.
+type waveform struct {
+	t0, dt float64
+	v      []float64
+}
+
+// parseWaveform converts the BYTE format data returned by :WAVeform:DATA?
+// into volts using the ten values of the :WAVeform:PREamble? response:
+// format, type, points, count, x increment, x origin, x reference,
+// y increment, y origin and y reference.
+func parseWaveform(preamble string, data []byte) (waveform, error) {
+	fields := strings.Split(strings.TrimSpace(preamble), ",")
+	if len(fields) != 10 {
+		return waveform{}, fmt.Errorf("preamble %q doesn't have 10 fields", preamble)
+	}
+	var p [10]float64
+	for i, f := range fields {
+		var err error
+		if p[i], err = strconv.ParseFloat(strings.TrimSpace(f), 64); err != nil {
+			return waveform{}, fmt.Errorf("preamble %q: invalid field %q", preamble, f)
+		}
+	}
+	if p[0] != 0 {
+		return waveform{}, fmt.Errorf("waveform format %g isn't BYTE", p[0])
+	}
+	xinc, xorig, xref := p[4], p[5], p[6]
+	yinc, yorig, yref := p[7], p[8], p[9]
+	if xinc <= 0 {
+		return waveform{}, fmt.Errorf("invalid x increment %g", xinc)
+	}
+	w := waveform{
+		t0: xorig - xref*xinc,
+		dt: xinc,
+		v:  make([]float64, len(data)),
+	}
+	for i, b := range data {
+		w.v[i] = (float64(b)-yref)*yinc + yorig
+	}
+	return w, nil
+}
+
+// time returns the time of sample i.
+func (w waveform) time(i int) float64 { return w.t0 + float64(i)*w.dt }
+
+// burst is a group of cycles found in a waveform.
+type burst struct {
+	start, end float64 // first and last time outside ±threshold
+	cycles     int
+	peak       float64
+	complete   bool // the whole burst is within the record
+}
+
+// bursts finds the bursts in the waveform. A cycle is counted each time the
+// signal rises above threshold after having been below -threshold, so the
+// idle level between bursts must be within ±threshold. A burst ends when the
+// signal stays within ±threshold for longer than gap.
+func (w waveform) bursts(threshold, gap float64) []burst {
+	var (
+		bs   []burst
+		cur  *burst
+		high bool
+	)
+	for i, v := range w.v {
+		t := w.time(i)
+		if math.Abs(v) <= threshold {
+			continue
+		}
+		if cur == nil || t-cur.end > gap {
+			bs = append(bs, burst{start: t, complete: t-w.t0 > gap})
+			cur = &bs[len(bs)-1]
+			high = false
+		}
+		cur.end = t
+		cur.peak = max(cur.peak, math.Abs(v))
+		switch {
+		case v > threshold && !high:
+			high = true
+			cur.cycles++
+		case v < -threshold:
+			high = false
+		}
+	}
+	if cur != nil && w.time(len(w.v)-1)-cur.end <= gap {
+		cur.complete = false
+	}
+	return bs
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+)
+
+var errFailed = errors.New("verification failed")
+
+func main() {
+	log.Println("IVI Bench DMM Verification Application")
+
+	var (
+		configFile string
+		psuName    string
+		channel    string
+		dmmName    string
+		pointList  string
+		htmlFile   string
+		junitFile  string
+		v          verifier
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the power supply and DMM",
+	)
+	flag.StringVar(
+		&psuName,
+		"psu",
+		"psu",
+		"Name of the e36000 reference supply in the bench config",
+	)
+	flag.StringVar(&channel, "channel", "", "Supply channel to use (empty = first)")
+	flag.StringVar(&dmmName, "dmm", "dmm", "Name of the DMM to verify in the bench config")
+	flag.StringVar(
+		&pointList,
+		"points",
+		"0:1,1:1,2:10,5:10",
+		"Comma-separated test points as voltage:DMM range",
+	)
+	flag.Float64Var(&v.current, "current", 0.1, "Supply current limit (A)")
+	flag.DurationVar(&v.settle, "settle", time.Second, "Settling time after each change")
+	flag.DurationVar(&v.maxTime, "maxtime", 5*time.Second, "Maximum time for each DMM reading")
+	flag.Float64Var(&v.tol.reading, "tol-reading", 0.02, "DMM tolerance, % of reading")
+	flag.Float64Var(&v.tol.rng, "tol-range", 0.005, "DMM tolerance, % of range")
+	flag.Float64Var(&v.tol.ref, "tol-ref", 0.05, "Supply readback uncertainty, % of reading")
+	flag.Float64Var(&v.tol.refOffset, "tol-ref-offset", 0.002, "Supply readback uncertainty offset (V)")
+	flag.Float64Var(&v.tol.supply, "tol-supply", 1, "Supply readback tolerance, % of setpoint")
+	flag.StringVar(
+		&htmlFile,
+		"html",
+		"certificate.html",
+		"File to write the HTML certificate to (empty = none)",
+	)
+	flag.StringVar(
+		&junitFile,
+		"junit",
+		"",
+		"File to write a JUnit XML report to (empty = none)",
+	)
+	flag.Parse()
+
+	points, err := parsePoints(pointList)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = run(configFile, psuName, channel, dmmName, points, &v, htmlFile, junitFile)
+	if errors.Is(err, errFailed) {
+		log.Println("FAIL")
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("PASS")
+}
+
+func run(
+	configFile, psuName, channel, dmmName string,
+	points []point,
+	v *verifier,
+	htmlFile, junitFile string,
+) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	var ok bool
+	if v.psu, ok = b.Instrument(psuName); !ok {
+		return fmt.Errorf("no DC power supply %q in the bench config", psuName)
+	}
+	if v.ch, err = v.psu.DCPwrChannelIndex(openCtx, channel); err != nil {
+		return err
+	}
+	if v.meter, ok = b.Instrument(dmmName); !ok || v.meter.Class != bench.ClassDMM {
+		return fmt.Errorf("no DMM %q in the bench config", dmmName)
+	}
+
+	rep := report.New("DMM verification certificate")
+	if rep.Instruments, err = report.Identify(openCtx, b); err != nil {
+		log.Printf("error identifying instruments: %s", err)
+	}
+	for _, id := range rep.Instruments {
+		if id.Name == dmmName {
+			rep.Title = fmt.Sprintf("DMM verification certificate: %s %s S/N %s",
+				id.Manufacturer, id.Model, id.SerialNumber)
+		}
+	}
+
+	p := rep.Begin(fmt.Sprintf("%s against %s", dmmName, psuName))
+	rows, err := v.run(ctx, points, p)
+	p.End(err)
+
+	printCertificate(os.Stdout, rep, rows)
+	if err := rep.WriteFiles(htmlFile, junitFile); err != nil {
+		return err
+	}
+	return rep.Err(errFailed)
+}
+
+// printCertificate prints the instruments and a table of the test points.
+func printCertificate(w io.Writer, rep *report.Report, rows []row) {
+	fmt.Fprintf(w, "\n%s\n%s\n\n", rep.Title, rep.Start.Format(time.RFC1123))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Instrument\tManufacturer\tModel\tSerial number\tFirmware\t")
+	for _, id := range rep.Instruments {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t\n", id.Name, id.Manufacturer, id.Model, id.SerialNumber, id.Firmware)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Setpoint\tRange\tReadback\tRef. uncertainty\tDMM reading\tDeviation\tLimits\tResult\t")
+	for _, r := range rows {
+		dev := r.reading.Value - r.readback.Value
+		verdict := r.reading.Verdict()
+		if !r.readback.Pass {
+			verdict = "FAIL (supply)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t±%s\t%s\t%s\t%s\t%s\t\n",
+			measure.Format(r.voltage, measure.Volt, 4),
+			measure.Format(r.rng, measure.Volt, 4),
+			r.readback.Measurement,
+			measure.Format(r.refUnc, measure.Volt, 3),
+			r.reading.Measurement,
+			measure.Format(dev, measure.Volt, 4),
+			r.reading.Limit.Format(measure.Volt),
+			verdict,
+		)
+	}
+	tw.Flush()
+	rep.WriteSummary(w)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// point is a test voltage and the fixed DMM range it's read on.
+type point struct {
+	voltage float64
+	rng     float64
+}
+
+// parsePoints parses a comma-separated list of voltage:range pairs, e.g.,
+// "1:1,5:10".
+func parsePoints(s string) ([]point, error) {
+	var points []point
+	for f := range strings.SplitSeq(s, ",") {
+		v, r, ok := strings.Cut(strings.TrimSpace(f), ":")
+		if !ok {
+			return nil, fmt.Errorf("test point %q isn't voltage:range", f)
+		}
+		var p point
+		var err error
+		if p.voltage, err = strconv.ParseFloat(v, 64); err != nil || p.voltage < 0 {
+			return nil, fmt.Errorf("test point %q: invalid voltage", f)
+		}
+		if p.rng, err = strconv.ParseFloat(r, 64); err != nil || p.rng <= 0 {
+			return nil, fmt.Errorf("test point %q: invalid range", f)
+		}
+		if p.voltage > p.rng*1.2 {
+			return nil, fmt.Errorf("test point %q: voltage is beyond the range", f)
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
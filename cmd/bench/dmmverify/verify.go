@@ -0,0 +1,170 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+	"github.com/gotmc/ivi/dmm"
+)
+
+// minSupplyTolerance keeps the supply readback limit from vanishing at 0 V.
+const minSupplyTolerance = 0.01 // V
+
+// tolerances are the acceptance limits of the verification.
+type tolerances struct {
+	reading   float64 // DMM, % of reading
+	rng       float64 // DMM, % of range
+	ref       float64 // supply readback uncertainty, % of reading
+	refOffset float64 // supply readback uncertainty, V
+	supply    float64 // supply readback, % of setpoint
+}
+
+// refUncertainty returns the uncertainty of a supply readback of v.
+func (t tolerances) refUncertainty(v float64) float64 {
+	return v*t.ref/100 + t.refOffset
+}
+
+// dmmLimit returns the limit for a DMM reading of the reference voltage v on
+// range rng: the DMM tolerance, in the style of a DMM accuracy specification,
+// widened by the uncertainty of the reference, which is much larger.
+func (t tolerances) dmmLimit(v, rng float64) measure.Limit {
+	return measure.Tolerance(v, v*t.reading/100+rng*t.rng/100+t.refUncertainty(v))
+}
+
+// row is one line of the certificate.
+type row struct {
+	point
+	readback measure.Result
+	refUnc   float64 // uncertainty of the readback, V
+	reading  measure.Result
+}
+
+// verifier steps the supply through the test points and reads each with the
+// DMM.
+type verifier struct {
+	psu     *bench.Instrument
+	ch      int
+	meter   *bench.Instrument
+	current float64
+	settle  time.Duration
+	maxTime time.Duration
+	tol     tolerances
+}
+
+func (v *verifier) channel(ctx context.Context, fn func(ch bench.DCPwrChannel) error) error {
+	return v.psu.Do(ctx, func(drv bench.Inherent) error {
+		ch, err := drv.(bench.DCPwr).Channel(v.ch)
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (v *verifier) dmm(ctx context.Context, fn func(m bench.DMM) error) error {
+	return v.meter.Do(ctx, func(drv bench.Inherent) error { return fn(drv.(bench.DMM)) })
+}
+
+// run measures every point, recording the results in p. The supply output is
+// turned off again even if ctx is cancelled.
+func (v *verifier) run(ctx context.Context, points []point, p *report.Procedure) ([]row, error) {
+	err := v.channel(ctx, func(ch bench.DCPwrChannel) error {
+		if err := ch.SetOutputEnabled(false); err != nil {
+			return err
+		}
+		if err := ch.SetVoltageLevel(0); err != nil {
+			return err
+		}
+		return ch.SetCurrentLimit(v.current)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", v.psu.Name, err)
+	}
+	err = v.dmm(ctx, func(m bench.DMM) error { return m.SetMeasurementFunction(dmm.DCVolts) })
+	if err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", v.meter.Name, err)
+	}
+
+	defer func() {
+		err := v.channel(context.WithoutCancel(ctx), func(ch bench.DCPwrChannel) error {
+			return ch.SetOutputEnabled(false)
+		})
+		if err != nil {
+			log.Printf("error turning off %s: %s", v.psu.Name, err)
+		}
+	}()
+	err = v.channel(ctx, func(ch bench.DCPwrChannel) error { return ch.SetOutputEnabled(true) })
+	if err != nil {
+		return nil, fmt.Errorf("error enabling %s output: %w", v.psu.Name, err)
+	}
+
+	var rows []row
+	for _, pt := range points {
+		r, err := v.measure(ctx, pt)
+		if err != nil {
+			return rows, err
+		}
+		log.Println(r.readback)
+		log.Println(r.reading)
+		p.RecordResult(r.readback)
+		p.RecordResult(r.reading)
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// measure sets the supply to a test point and reads it back with the supply
+// and the DMM.
+func (v *verifier) measure(ctx context.Context, pt point) (row, error) {
+	r := row{point: pt}
+	name := measure.Format(pt.voltage, measure.Volt, 4)
+	err := v.channel(ctx, func(ch bench.DCPwrChannel) error { return ch.SetVoltageLevel(pt.voltage) })
+	if err != nil {
+		return r, fmt.Errorf("error setting %s to %s: %w", v.psu.Name, name, err)
+	}
+	err = v.dmm(ctx, func(m bench.DMM) error { return m.SetRange(dmm.AutoOff, pt.rng) })
+	if err != nil {
+		return r, fmt.Errorf("error setting %s range to %g V: %w", v.meter.Name, pt.rng, err)
+	}
+
+	select {
+	case <-time.After(v.settle):
+	case <-ctx.Done():
+		return r, ctx.Err()
+	}
+
+	var readback, reading float64
+	err = v.channel(ctx, func(ch bench.DCPwrChannel) (err error) {
+		readback, err = ch.MeasureVoltage()
+		return err
+	})
+	if err != nil {
+		return r, fmt.Errorf("error measuring %s output: %w", v.psu.Name, err)
+	}
+	err = v.dmm(ctx, func(m bench.DMM) (err error) {
+		reading, err = m.ReadMeasurement(v.maxTime)
+		return err
+	})
+	if err != nil {
+		return r, fmt.Errorf("error reading %s: %w", v.meter.Name, err)
+	}
+
+	r.readback = measure.Check(name+" supply readback", measure.Voltage(readback),
+		measure.Tolerance(pt.voltage, max(pt.voltage*v.tol.supply/100, minSupplyTolerance)))
+	// The readback is the reference, since the supply's measurement is
+	// usually better than its setpoint accuracy.
+	r.refUnc = v.tol.refUncertainty(readback)
+	r.reading = measure.Check(fmt.Sprintf("%s on %g V range", name, pt.rng),
+		measure.DMM(dmm.DCVolts, reading), v.tol.dmmLimit(readback, pt.rng))
+	return r, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func main() {
+	log.Println("IVI Bench Prometheus Exporter Application")
+
+	var (
+		configFile string
+		addr       string
+		interval   time.Duration
+		maxTime    time.Duration
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments to export",
+	)
+	flag.StringVar(&addr, "addr", ":9110", "Address to serve /metrics on")
+	flag.DurationVar(&interval, "interval", 10*time.Second, "Time between polls of each instrument")
+	flag.DurationVar(&maxTime, "maxtime", 2*time.Second, "Maximum time for a DMM reading")
+	flag.Parse()
+
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		log.Fatalf("error opening bench: %s", err)
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+
+	reg := prometheus.NewRegistry()
+	m := newMetrics(reg)
+
+	// Each instrument is polled on its own schedule so a slow or unreachable
+	// instrument doesn't delay the others. Instruments of other classes are
+	// ignored.
+	var wg sync.WaitGroup
+	for _, inst := range b.Instruments() {
+		if inst.Class != bench.ClassDCPwr && inst.Class != bench.ClassDMM {
+			log.Printf("Skipping %s %s", inst.Class, inst.Name)
+			continue
+		}
+		p, err := newPoller(ctx, inst, m, maxTime)
+		if err != nil {
+			log.Fatalf("error identifying %s: %s", inst.Name, err)
+		}
+		log.Printf("Exporting %s %s (%s %s)", inst.Class, inst.Name, p.model, p.serial)
+		wg.Go(func() { p.run(ctx, interval) })
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	srv := http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		log.Println("Shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("error shutting down: %s", err)
+		}
+	}()
+
+	log.Printf("Serving metrics on %s/metrics", addr)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("HTTP server error: %s", err)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dcpwr"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// outputStates are the dcpwr output states exported. States a driver doesn't
+// support are dropped after the first poll.
+var outputStates = []dcpwr.OutputState{
+	dcpwr.ConstantVoltage,
+	dcpwr.ConstantCurrent,
+	dcpwr.OverVoltage,
+	dcpwr.OverCurrent,
+	dcpwr.Unregulated,
+}
+
+// metrics are the gauges exported for all instruments.
+type metrics struct {
+	up          *prometheus.GaugeVec
+	pollErrors  *prometheus.CounterVec
+	voltage     *prometheus.GaugeVec
+	current     *prometheus.GaugeVec
+	outputState *prometheus.GaugeVec
+	reading     *prometheus.GaugeVec
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	inst := []string{"instrument", "model", "serial"}
+	ch := slices.Concat(inst, []string{"channel"})
+	m := metrics{
+		up: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ivi_up",
+			Help: "Whether the last poll of the instrument succeeded.",
+		}, inst),
+		pollErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ivi_poll_errors_total",
+			Help: "Number of failed polls of the instrument.",
+		}, inst),
+		voltage: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ivi_dcpwr_voltage_volts",
+			Help: "Measured output voltage of a DC power supply channel.",
+		}, ch),
+		current: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ivi_dcpwr_current_amperes",
+			Help: "Measured output current of a DC power supply channel.",
+		}, ch),
+		outputState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ivi_dcpwr_output_state",
+			Help: "1 if a DC power supply channel is in the output state, else 0.",
+		}, slices.Concat(ch, []string{"state"})),
+		reading: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ivi_dmm_reading",
+			Help: "Last reading of a DMM in the units of its measurement function.",
+		}, slices.Concat(inst, []string{"function"})),
+	}
+	reg.MustRegister(m.up, m.pollErrors, m.voltage, m.current, m.outputState, m.reading)
+	return &m
+}
+
+// poller polls one instrument.
+type poller struct {
+	inst    *bench.Instrument
+	m       *metrics
+	maxTime time.Duration
+	model   string
+	serial  string
+	labels  prometheus.Labels
+	states  []dcpwr.OutputState
+
+	function string // DMM measurement function of the exported reading
+}
+
+// newPoller queries the model and serial number used to label the metrics.
+func newPoller(
+	ctx context.Context,
+	inst *bench.Instrument,
+	m *metrics,
+	maxTime time.Duration,
+) (*poller, error) {
+	p := poller{
+		inst:    inst,
+		m:       m,
+		maxTime: maxTime,
+		states:  outputStates,
+	}
+	err := inst.Do(ctx, func(drv bench.Inherent) error {
+		var err error
+		if p.model, err = drv.InstrumentModel(); err != nil {
+			return fmt.Errorf("error querying instrument model: %w", err)
+		}
+		if p.serial, err = drv.InstrumentSerialNumber(); err != nil {
+			return fmt.Errorf("error querying instrument sn: %w", err)
+		}
+		return nil
+	})
+	p.labels = prometheus.Labels{"instrument": inst.Name, "model": p.model, "serial": p.serial}
+	return &p, err
+}
+
+// run polls the instrument every interval until ctx is done.
+func (p *poller) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *poller) poll(ctx context.Context) {
+	err := p.inst.Do(ctx, func(drv bench.Inherent) error {
+		switch drv := drv.(type) {
+		case bench.DCPwr:
+			return p.pollDCPwr(drv)
+		case bench.DMM:
+			return p.pollDMM(drv)
+		}
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("error polling %s: %s", p.inst.Name, err)
+		p.m.pollErrors.With(p.labels).Inc()
+		p.m.up.With(p.labels).Set(0)
+		// Drop the stale readings so dashboards show a gap rather than the
+		// last value.
+		for _, g := range []*prometheus.GaugeVec{p.m.voltage, p.m.current, p.m.outputState, p.m.reading} {
+			g.DeletePartialMatch(p.labels)
+		}
+		return
+	}
+	p.m.up.With(p.labels).Set(1)
+}
+
+func (p *poller) pollDCPwr(ps bench.DCPwr) error {
+	for i := range ps.OutputChannelCount() {
+		ch, err := ps.Channel(i)
+		if err != nil {
+			return fmt.Errorf("error getting channel %d: %w", i, err)
+		}
+		labels := maps.Clone(p.labels)
+		labels["channel"] = ch.Name()
+		v, err := ch.MeasureVoltage()
+		if err != nil {
+			return fmt.Errorf("error measuring %s voltage: %w", ch.Name(), err)
+		}
+		p.m.voltage.With(labels).Set(v)
+		a, err := ch.MeasureCurrent()
+		if err != nil {
+			return fmt.Errorf("error measuring %s current: %w", ch.Name(), err)
+		}
+		p.m.current.With(labels).Set(a)
+		if err := p.pollOutputStates(ch, labels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *poller) pollOutputStates(ch bench.DCPwrChannel, labels prometheus.Labels) error {
+	supported := p.states[:0:0]
+	for _, state := range p.states {
+		active, err := ch.QueryOutputState(state)
+		if errors.Is(err, ivi.ErrFunctionNotSupported) || errors.Is(err, ivi.ErrNotImplemented) {
+			log.Printf("%s doesn't support querying the %s state", p.inst.Name, state)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error querying %s %s state: %w", ch.Name(), state, err)
+		}
+		supported = append(supported, state)
+		g := p.m.outputState.MustCurryWith(labels).WithLabelValues(state.String())
+		if active {
+			g.Set(1)
+		} else {
+			g.Set(0)
+		}
+	}
+	p.states = supported
+	return nil
+}
+
+func (p *poller) pollDMM(d bench.DMM) error {
+	fcn, err := d.MeasurementFunction()
+	if err != nil {
+		return fmt.Errorf("error querying measurement function: %w", err)
+	}
+	v, err := d.ReadMeasurement(p.maxTime)
+	if err != nil {
+		return fmt.Errorf("error reading measurement: %w", err)
+	}
+	// Only export the current function so a front panel change doesn't
+	// leave a stale series for the old one. The new series is set first so
+	// that a scrape in between still sees a reading.
+	reading := p.m.reading.MustCurryWith(p.labels)
+	reading.WithLabelValues(fcn.String()).Set(v)
+	if p.function != "" && p.function != fcn.String() {
+		reading.DeleteLabelValues(p.function)
+	}
+	p.function = fcn.String()
+	return nil
+}
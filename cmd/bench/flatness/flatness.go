@@ -0,0 +1,189 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+)
+
+// periods is the number of signal periods captured by the scope.
+const periods = 10
+
+// row is one line of the flatness table.
+type row struct {
+	freq      float64
+	vpp       measure.Result
+	flatness  measure.Result // dB relative to the reference frequency
+	frequency measure.Result
+}
+
+// checker sets the generator to each frequency and measures it with the
+// scope.
+type checker struct {
+	gen       *bench.Instrument
+	genCh     int
+	scope     *bench.Instrument
+	scopeCh   int
+	amplitude float64 // Vpp into 50 Ω
+	ampTol    float64 // % of amplitude at the reference frequency
+	freqTol   float64 // % of frequency
+	spec      spec
+	settle    time.Duration
+	maxTime   time.Duration
+}
+
+func (c *checker) genChannel(ctx context.Context, fn func(ch bench.FGenChannel) error) error {
+	return c.gen.Do(ctx, func(drv bench.Inherent) error {
+		ch, err := drv.(bench.FGen).Channel(c.genCh)
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (c *checker) scopeChannel(
+	ctx context.Context,
+	fn func(sc bench.ScopeTiming, ch bench.ScopeChannel) error,
+) error {
+	return c.scope.Do(ctx, func(drv bench.Inherent) error {
+		sc, ok := bench.As[bench.ScopeTiming](drv)
+		if !ok {
+			return fmt.Errorf("%s driver can't set the timebase and trigger", c.scope.Driver)
+		}
+		ch, err := drv.(bench.Scope).Channel(c.scopeCh)
+		if err != nil {
+			return err
+		}
+		return fn(sc, ch)
+	})
+}
+
+// setup terminates the scope input in 50 Ω and sets its vertical range and
+// trigger for the amplitude.
+func (c *checker) setup(ctx context.Context) error {
+	return c.scopeChannel(ctx, func(sc bench.ScopeTiming, ch bench.ScopeChannel) error {
+		vert, ok := ch.(bench.ScopeVertical)
+		if !ok {
+			return fmt.Errorf("%s driver can't set the input impedance", c.scope.Driver)
+		}
+		if err := vert.SetInputImpedance(50.0); err != nil {
+			return err
+		}
+		// Use 5 of the 8 vertical divisions.
+		if err := vert.Configure(c.amplitude*8/5, 0, scope.DCVerticalCoupling, false, 1, true); err != nil {
+			return err
+		}
+		if err := sc.SetTriggerType(scope.EdgeTrigger); err != nil {
+			return err
+		}
+		return sc.SetTriggerLevel(0)
+	})
+}
+
+// run measures every frequency, recording the results in p. The generator
+// output is turned off again even if ctx is cancelled.
+func (c *checker) run(ctx context.Context, freqs []float64, p *report.Procedure) ([]row, error) {
+	if err := c.setup(ctx); err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", c.scope.Name, err)
+	}
+	err := c.genChannel(ctx, func(ch bench.FGenChannel) error {
+		if err := ch.ConfigureStandardWaveform(fgen.Sine, c.amplitude, 0, freqs[0], 0); err != nil {
+			return err
+		}
+		return ch.EnableOutput()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting up %s: %w", c.gen.Name, err)
+	}
+	defer func() {
+		err := c.genChannel(context.WithoutCancel(ctx), func(ch bench.FGenChannel) error {
+			return ch.DisableOutput()
+		})
+		if err != nil {
+			log.Printf("error turning off %s: %s", c.gen.Name, err)
+		}
+	}()
+
+	var (
+		rows []row
+		ref  float64 // Vpp at the reference frequency
+	)
+	for i, freq := range freqs {
+		vpp, measFreq, err := c.measure(ctx, freq)
+		if err != nil {
+			return rows, err
+		}
+		name := measure.Format(freq, measure.Hertz, 4)
+		r := row{freq: freq}
+		// Only the amplitude at the reference frequency is checked; the
+		// others are checked relative to it.
+		var ampLimit measure.Limit
+		if i == 0 {
+			ref = vpp
+			ampLimit = measure.TolerancePercent(c.amplitude, c.ampTol)
+		}
+		r.vpp = measure.Check(name+" amplitude",
+			measure.Measurement{Value: vpp, Unit: measure.VoltPeakToPeak}, ampLimit)
+		r.flatness = measure.Check(name+" flatness",
+			measure.Measurement{Value: 20 * math.Log10(vpp/ref), Unit: measure.Decibel},
+			measure.Tolerance(0, c.spec.limit(freq)))
+		r.frequency = measure.Check(name+" frequency",
+			measure.Measurement{Value: measFreq, Unit: measure.Hertz},
+			measure.TolerancePercent(freq, c.freqTol))
+		if i == 0 {
+			p.RecordResult(r.vpp)
+		}
+		log.Println(r.flatness)
+		p.RecordResult(r.flatness)
+		p.RecordResult(r.frequency)
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// measure sets the generator frequency and the scope timebase and then
+// measures the peak-to-peak voltage and frequency of one acquisition.
+func (c *checker) measure(ctx context.Context, freq float64) (vpp, measFreq float64, err error) {
+	err = c.genChannel(ctx, func(ch bench.FGenChannel) error { return ch.SetFrequency(freq) })
+	if err != nil {
+		return 0, 0, fmt.Errorf("error setting %s frequency: %w", c.gen.Name, err)
+	}
+	err = c.scopeChannel(ctx, func(sc bench.ScopeTiming, _ bench.ScopeChannel) error {
+		return sc.SetAcquisitionTimePerRecord(time.Duration(periods / freq * float64(time.Second)))
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("error setting %s timebase: %w", c.scope.Name, err)
+	}
+
+	select {
+	case <-time.After(c.settle):
+	case <-ctx.Done():
+		return 0, 0, ctx.Err()
+	}
+
+	err = c.scopeChannel(ctx, func(_ bench.ScopeTiming, ch bench.ScopeChannel) error {
+		if vpp, err = ch.ReadWaveformMeasurement(scope.VoltagePeakToPeak, c.maxTime); err != nil {
+			return err
+		}
+		measFreq, err = ch.FetchWaveformMeasurement(scope.Frequency)
+		return err
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("error measuring on %s: %w", c.scope.Name, err)
+	}
+	return vpp, measFreq, nil
+}
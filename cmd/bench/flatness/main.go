@@ -0,0 +1,176 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+)
+
+var errFailed = errors.New("flatness check failed")
+
+func main() {
+	log.Println("IVI Bench Function Generator Flatness Application")
+
+	var (
+		configFile string
+		genName    string
+		scopeName  string
+		freqList   string
+		specList   string
+		htmlFile   string
+		junitFile  string
+		c          checker
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the function generator and scope",
+	)
+	flag.StringVar(&genName, "fgen", "fgen", "Name of the kt33000 generator in the bench config")
+	flag.IntVar(&c.genCh, "fgen-channel", 0, "0-based generator channel")
+	flag.StringVar(&scopeName, "scope", "scope", "Name of the infiniivision scope in the bench config")
+	flag.IntVar(&c.scopeCh, "scope-channel", 0, "0-based scope channel")
+	flag.Float64Var(&c.amplitude, "amplitude", 1, "Sine amplitude into 50 Ω (Vpp)")
+	flag.StringVar(
+		&freqList,
+		"frequencies",
+		"1e3,1e4,1e5,1e6,5e6,1e7,2e7",
+		"Comma-separated test frequencies (Hz); the lowest is the reference",
+	)
+	flag.StringVar(
+		&specList,
+		"spec",
+		"1e5:0.1,5e6:0.15,2e7:0.3,3e7:0.4",
+		"Comma-separated flatness limits as maxFreq:dB relative to the reference",
+	)
+	flag.Float64Var(&c.ampTol, "amp-tol", 5, "Amplitude tolerance at the reference frequency (%)")
+	flag.Float64Var(&c.freqTol, "freq-tol", 1, "Measured frequency tolerance (%)")
+	flag.DurationVar(&c.settle, "settle", 500*time.Millisecond, "Settling time after each change")
+	flag.DurationVar(&c.maxTime, "maxtime", 5*time.Second, "Maximum time for each scope acquisition")
+	flag.StringVar(
+		&htmlFile,
+		"html",
+		"flatness.html",
+		"File to write the HTML report to (empty = none)",
+	)
+	flag.StringVar(
+		&junitFile,
+		"junit",
+		"",
+		"File to write a JUnit XML report to (empty = none)",
+	)
+	flag.Parse()
+
+	freqs, err := parseFrequencies(freqList)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if c.spec, err = parseSpec(specList); err != nil {
+		log.Fatal(err)
+	}
+	if !c.spec.covers(freqs) {
+		log.Fatalf("the flatness spec doesn't cover %g Hz", freqs[len(freqs)-1])
+	}
+	err = run(configFile, genName, scopeName, freqs, &c, htmlFile, junitFile)
+	if errors.Is(err, errFailed) {
+		log.Println("FAIL")
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("PASS")
+}
+
+func run(
+	configFile, genName, scopeName string,
+	freqs []float64,
+	c *checker,
+	htmlFile, junitFile string,
+) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	var ok bool
+	if c.gen, ok = b.Instrument(genName); !ok || c.gen.Class != bench.ClassFGen {
+		return fmt.Errorf("no function generator %q in the bench config", genName)
+	}
+	if c.scope, ok = b.Instrument(scopeName); !ok || c.scope.Class != bench.ClassScope {
+		return fmt.Errorf("no oscilloscope %q in the bench config", scopeName)
+	}
+
+	rep := report.New(fmt.Sprintf("%s amplitude flatness", genName))
+	if rep.Instruments, err = report.Identify(openCtx, b); err != nil {
+		log.Printf("error identifying instruments: %s", err)
+	}
+	p := rep.Begin(fmt.Sprintf("%s %s sine into 50 Ω on %s",
+		genName, measure.Format(c.amplitude, measure.VoltPeakToPeak, 4), scopeName))
+	rows, err := c.run(ctx, freqs, p)
+	p.End(err)
+
+	printTable(os.Stdout, rep, rows)
+	if err := rep.WriteFiles(htmlFile, junitFile); err != nil {
+		return err
+	}
+	return rep.Err(errFailed)
+}
+
+// printTable prints the deviation versus frequency.
+func printTable(w io.Writer, rep *report.Report, rows []row) {
+	fmt.Fprintf(w, "\n%s\n%s\n\n", rep.Procedures[0].Name, rep.Start.Format(time.RFC1123))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Frequency\tMeasured\tAmplitude\tDeviation\tLimits\tResult\t")
+	for _, r := range rows {
+		verdict := r.flatness.Verdict()
+		switch {
+		case !r.vpp.Pass:
+			verdict = "FAIL (amplitude)"
+		case !r.frequency.Pass:
+			verdict = "FAIL (frequency)"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			measure.Format(r.freq, measure.Hertz, 4),
+			r.frequency.Measurement,
+			r.vpp.Measurement,
+			measure.Format(r.flatness.Value, measure.Decibel, 3),
+			r.flatness.Limit.Format(measure.Decibel),
+			verdict,
+		)
+	}
+	tw.Flush()
+	rep.WriteSummary(w)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// band is a flatness specification: the deviation from the amplitude at the
+// reference frequency must be within ±dB up to maxFreq.
+type band struct {
+	maxFreq float64
+	dB      float64
+}
+
+// spec is a list of bands sorted by frequency.
+type spec []band
+
+// parseSpec parses a comma-separated list of maxFreq:dB pairs, e.g.,
+// "100e3:0.1,5e6:0.15".
+func parseSpec(s string) (spec, error) {
+	var sp spec
+	for f := range strings.SplitSeq(s, ",") {
+		freq, dB, ok := strings.Cut(strings.TrimSpace(f), ":")
+		if !ok {
+			return nil, fmt.Errorf("spec band %q isn't maxFreq:dB", f)
+		}
+		var b band
+		var err error
+		if b.maxFreq, err = strconv.ParseFloat(freq, 64); err != nil || b.maxFreq <= 0 {
+			return nil, fmt.Errorf("spec band %q: invalid frequency", f)
+		}
+		if b.dB, err = strconv.ParseFloat(dB, 64); err != nil || b.dB <= 0 {
+			return nil, fmt.Errorf("spec band %q: invalid flatness", f)
+		}
+		sp = append(sp, b)
+	}
+	slices.SortFunc(sp, func(a, b band) int { return cmp.Compare(a.maxFreq, b.maxFreq) })
+	return sp, nil
+}
+
+// covers reports whether the spec has a band for every frequency.
+func (sp spec) covers(freqs []float64) bool {
+	return len(sp) > 0 && slices.Max(freqs) <= sp[len(sp)-1].maxFreq
+}
+
+// limit returns the flatness limit in dB at a frequency covered by the spec.
+func (sp spec) limit(freq float64) float64 {
+	for _, b := range sp {
+		if freq <= b.maxFreq {
+			return b.dB
+		}
+	}
+	return 0
+}
+
+// parseFrequencies parses a comma-separated list of frequencies in Hz and
+// sorts them, so the first is the reference frequency.
+func parseFrequencies(s string) ([]float64, error) {
+	var freqs []float64
+	for f := range strings.SplitSeq(s, ",") {
+		freq, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil || freq <= 0 {
+			return nil, fmt.Errorf("invalid frequency %q", f)
+		}
+		freqs = append(freqs, freq)
+	}
+	slices.Sort(freqs)
+	return freqs, nil
+}
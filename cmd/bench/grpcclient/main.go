@@ -0,0 +1,108 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/rpc"
+	"github.com/gotmc/ivi/dmm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	log.Println("IVI Bench gRPC Client Application")
+
+	var (
+		addr    string
+		dmmName string
+		psuName string
+		timeout time.Duration
+	)
+	flag.StringVar(&addr, "addr", "localhost:50051", "Address of the bench gRPC server")
+	flag.StringVar(&dmmName, "dmm", "dmm", "Name of the DMM in the bench config")
+	flag.StringVar(&psuName, "psu", "psu", "Name of the power supply in the bench config")
+	flag.DurationVar(&timeout, "timeout", 10*time.Second, "Timeout for each call")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("error creating client for %s: %s", addr, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Printf("error closing connection: %s", err)
+		}
+	}()
+	client := rpc.NewClient(conn, rpc.WithTimeout(timeout))
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	instruments, err := client.ListInstruments(ctx)
+	if err != nil {
+		log.Fatalf("error listing instruments: %s", err)
+	}
+	for _, inst := range instruments {
+		log.Printf("%s: %s %s at %s", inst.GetName(), inst.GetClass(), inst.GetDriver(), inst.GetAddress())
+	}
+
+	// The remote handles satisfy the same interfaces as the local drivers.
+	measureDC(client.DMM(dmmName))
+	setOutput(client.DCPwr(psuName))
+}
+
+func measureDC(meter bench.DMM) {
+	model, err := meter.InstrumentModel()
+	if err != nil {
+		log.Fatalf("error querying instrument model: %s", err)
+	}
+	log.Printf("DMM model: %s", model)
+	if err := meter.SetMeasurementFunction(dmm.DCVolts); err != nil {
+		log.Fatalf("error setting measurement function: %s", err)
+	}
+	if err := meter.SetRange(dmm.AutoOn, 0); err != nil {
+		log.Fatalf("error setting auto range: %s", err)
+	}
+	v, err := meter.ReadMeasurement(5 * time.Second)
+	if err != nil {
+		log.Fatalf("error reading measurement: %s", err)
+	}
+	log.Printf("DMM reading: %.6f VDC", v)
+}
+
+func setOutput(ps bench.DCPwr) {
+	model, err := ps.InstrumentModel()
+	if err != nil {
+		log.Fatalf("error querying instrument model: %s", err)
+	}
+	log.Printf("PSU model: %s", model)
+	ch, err := ps.Channel(0)
+	if err != nil {
+		log.Fatalf("error getting channel: %s", err)
+	}
+	if err := ch.SetVoltageLevel(5.0); err != nil {
+		log.Fatalf("error setting voltage: %s", err)
+	}
+	if err := ch.SetCurrentLimit(0.1); err != nil {
+		log.Fatalf("error setting current limit: %s", err)
+	}
+	if err := ch.ConfigureOVP(true, 6.0); err != nil {
+		log.Fatalf("error configuring OVP: %s", err)
+	}
+	if err := ch.SetOutputEnabled(true); err != nil {
+		log.Fatalf("error enabling output: %s", err)
+	}
+	v, err := ch.MeasureVoltage()
+	if err != nil {
+		log.Fatalf("error measuring voltage: %s", err)
+	}
+	log.Printf("PSU %s output: %.3f V", ch.Name(), v)
+}
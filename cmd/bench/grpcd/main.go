@@ -0,0 +1,85 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/rpc"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	log.Println("IVI Bench gRPC Server Application")
+
+	var (
+		configFile string
+		addr       string
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments to serve",
+	)
+	flag.StringVar(&addr, "addr", ":50051", "Address to listen on")
+	limitsFile := limits.Flag()
+	flag.Parse()
+
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		log.Fatalf("error opening bench: %s", err)
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	if err := limits.ApplyFile(b, *limitsFile); err != nil {
+		log.Fatal(err)
+	}
+	for _, inst := range b.Instruments() {
+		log.Printf("Serving %s %s (%s) at %s", inst.Class, inst.Name, inst.Driver, inst.Address)
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("error listening on %s: %s", addr, err)
+	}
+
+	// As with the HTTP server, calls for the same instrument are serialized by
+	// bench.Instrument.Do.
+	srv := grpc.NewServer()
+	rpc.Register(srv, b)
+	go func() {
+		<-ctx.Done()
+		log.Println("Shutting down")
+		srv.GracefulStop()
+	}()
+
+	log.Printf("Listening on %s", lis.Addr())
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("gRPC server error: %s", err)
+	}
+}
@@ -0,0 +1,701 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+)
+
+type instrumentInfo struct {
+	Name    string `json:"name"`
+	Driver  string `json:"driver"`
+	Class   string `json:"class" doc:"IVI class: dcpwr, dmm, fgen, scope, or swtch"`
+	Address string `json:"address"`
+}
+
+type identity struct {
+	Manufacturer string `json:"manufacturer"`
+	Model        string `json:"model"`
+	SerialNumber string `json:"serial_number"`
+	Firmware     string `json:"firmware"`
+}
+
+type channelList struct {
+	Channels []string `json:"channels" doc:"Channel names, indexed from 0"`
+}
+
+type level struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit,omitempty" doc:"Ignored in requests"`
+}
+
+type outputState struct {
+	Enabled bool `json:"enabled"`
+}
+
+type psuMeasurement struct {
+	Voltage float64 `json:"voltage" doc:"Measured output voltage in V"`
+	Current float64 `json:"current" doc:"Measured output current in A"`
+}
+
+type dmmFunction struct {
+	Function string `json:"function" doc:"Measurement function name, e.g., as returned by GET"`
+}
+
+type dmmRange struct {
+	Auto  string  `json:"auto" doc:"Auto range setting name, e.g., as returned by GET"`
+	Range float64 `json:"range" doc:"Manual range in the function's units"`
+}
+
+type readRequest struct {
+	MaxTime string `json:"max_time,omitempty" doc:"Maximum measurement time, e.g., \"1s\""`
+}
+
+type dmmReading struct {
+	Function string  `json:"function"`
+	Value    float64 `json:"value"`
+}
+
+type waveform struct {
+	Waveform  string  `json:"waveform" doc:"Standard waveform name, e.g., as returned by GET"`
+	Frequency float64 `json:"frequency" doc:"Frequency in Hz"`
+	Amplitude float64 `json:"amplitude" doc:"Amplitude in Vpp"`
+	Offset    float64 `json:"offset" doc:"DC offset in V"`
+}
+
+type scopeMeasureRequest struct {
+	Function string `json:"function" doc:"Waveform measurement function name"`
+	MaxTime  string `json:"max_time,omitempty" doc:"Maximum time to wait for a trigger, e.g., \"1s\""`
+}
+
+type scopeMeasurement struct {
+	Function string  `json:"function"`
+	Value    float64 `json:"value"`
+}
+
+type connection struct {
+	Channel1 string `json:"channel1" doc:"Channel name or virtual name"`
+	Channel2 string `json:"channel2" doc:"Channel name or virtual name"`
+}
+
+type channelCount struct {
+	Count int `json:"count"`
+}
+
+// server exposes the instruments on a bench over HTTP.
+type server struct {
+	bench *bench.Bench
+}
+
+// endpoints returns the API routes. The OpenAPI document is generated from
+// the same list.
+func (s *server) endpoints() []endpoint {
+	return []endpoint{
+		newEndpoint("GET", "/instruments", "instruments",
+			"List the instruments", s.listInstruments),
+		newEndpoint("GET", "/instruments/{name}", "instruments",
+			"Identify an instrument", s.identify),
+		newEndpoint("POST", "/instruments/{name}/reset", "instruments",
+			"Reset an instrument", s.reset),
+
+		newEndpoint("GET", "/dcpwr/{name}/channels", "dcpwr",
+			"List the outputs of a power supply", s.dcpwrChannels),
+		newEndpoint("GET", "/dcpwr/{name}/channels/{ch}/voltage", "dcpwr",
+			"Get the programmed voltage level", s.getVoltage),
+		newEndpoint("PUT", "/dcpwr/{name}/channels/{ch}/voltage", "dcpwr",
+			"Set the voltage level", s.setVoltage),
+		newEndpoint("GET", "/dcpwr/{name}/channels/{ch}/current-limit", "dcpwr",
+			"Get the current limit", s.getCurrentLimit),
+		newEndpoint("PUT", "/dcpwr/{name}/channels/{ch}/current-limit", "dcpwr",
+			"Set the current limit", s.setCurrentLimit),
+		newEndpoint("GET", "/dcpwr/{name}/channels/{ch}/output", "dcpwr",
+			"Get whether the output is enabled", s.getPSUOutput),
+		newEndpoint("PUT", "/dcpwr/{name}/channels/{ch}/output", "dcpwr",
+			"Enable or disable the output", s.setPSUOutput),
+		newEndpoint("GET", "/dcpwr/{name}/channels/{ch}/measurement", "dcpwr",
+			"Measure the output voltage and current", s.measurePSU),
+
+		newEndpoint("GET", "/dmm/{name}/function", "dmm",
+			"Get the measurement function", s.getFunction),
+		newEndpoint("PUT", "/dmm/{name}/function", "dmm",
+			"Set the measurement function", s.setFunction),
+		newEndpoint("GET", "/dmm/{name}/range", "dmm",
+			"Get the range", s.getRange),
+		newEndpoint("PUT", "/dmm/{name}/range", "dmm",
+			"Set the range", s.setRange),
+		newEndpoint("POST", "/dmm/{name}/read", "dmm",
+			"Take a measurement", s.readDMM),
+
+		newEndpoint("GET", "/fgen/{name}/channels", "fgen",
+			"List the outputs of a function generator", s.fgenChannels),
+		newEndpoint("GET", "/fgen/{name}/channels/{ch}/waveform", "fgen",
+			"Get the standard waveform settings", s.getWaveform),
+		newEndpoint("PUT", "/fgen/{name}/channels/{ch}/waveform", "fgen",
+			"Configure a standard waveform", s.setWaveform),
+		newEndpoint("GET", "/fgen/{name}/channels/{ch}/output", "fgen",
+			"Get whether the output is enabled", s.getFGenOutput),
+		newEndpoint("PUT", "/fgen/{name}/channels/{ch}/output", "fgen",
+			"Enable or disable the output", s.setFGenOutput),
+
+		newEndpoint("GET", "/scope/{name}/channels", "scope",
+			"List the inputs of an oscilloscope", s.scopeChannels),
+		newEndpoint("POST", "/scope/{name}/channels/{ch}/measure", "scope",
+			"Take a waveform measurement", s.measureScope),
+
+		newEndpoint("GET", "/swtch/{name}/channels", "swtch",
+			"Get the number of channels of a switch matrix", s.swtchChannels),
+		newEndpoint("POST", "/swtch/{name}/connect", "swtch",
+			"Connect two channels", s.connect),
+		newEndpoint("POST", "/swtch/{name}/disconnect", "swtch",
+			"Disconnect two channels", s.disconnect),
+		newEndpoint("POST", "/swtch/{name}/disconnect-all", "swtch",
+			"Disconnect all channels", s.disconnectAll),
+	}
+}
+
+// lookup returns the instrument named in the request path, checking that it
+// belongs to the class. An empty class matches any instrument.
+func (s *server) lookup(r *http.Request, class bench.Class) (*bench.Instrument, error) {
+	name := r.PathValue("name")
+	inst, ok := s.bench.Instrument(name)
+	if !ok {
+		return nil, notFound("no instrument named %q", name)
+	}
+	if class != "" && inst.Class != class {
+		return nil, notFound("instrument %q is a %s, not a %s", name, inst.Class, class)
+	}
+	return inst, nil
+}
+
+func channelIndex(r *http.Request) (int, error) {
+	i, err := strconv.Atoi(r.PathValue("ch"))
+	if err != nil || i < 0 {
+		return 0, badRequest("invalid channel index %q", r.PathValue("ch"))
+	}
+	return i, nil
+}
+
+// parseEnum looks up an IVI enum value by name, reporting an unknown name as
+// a bad request.
+func parseEnum[T interface {
+	~int
+	fmt.Stringer
+}](kind, name string) (T, error) {
+	v, err := bench.ParseEnum[T](name)
+	if err != nil {
+		return v, badRequest("unknown %s %q", kind, name)
+	}
+	return v, nil
+}
+
+func parseMaxTime(s string) (time.Duration, error) {
+	if s == "" {
+		return time.Second, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, badRequest("invalid max_time %q", s)
+	}
+	return d, nil
+}
+
+func (s *server) listInstruments(r *http.Request, _ empty) ([]instrumentInfo, error) {
+	insts := s.bench.Instruments()
+	infos := make([]instrumentInfo, 0, len(insts))
+	for _, inst := range insts {
+		infos = append(infos, instrumentInfo{
+			Name:    inst.Name,
+			Driver:  inst.Driver,
+			Class:   string(inst.Class),
+			Address: inst.Address,
+		})
+	}
+	return infos, nil
+}
+
+func (s *server) identify(r *http.Request, _ empty) (identity, error) {
+	var id identity
+	inst, err := s.lookup(r, "")
+	if err != nil {
+		return id, err
+	}
+	err = inst.Do(r.Context(), func(drv bench.Inherent) error {
+		var err error
+		if id.Manufacturer, err = drv.InstrumentManufacturer(); err != nil {
+			return fmt.Errorf("error querying instrument manufacturer: %w", err)
+		}
+		if id.Model, err = drv.InstrumentModel(); err != nil {
+			return fmt.Errorf("error querying instrument model: %w", err)
+		}
+		if id.SerialNumber, err = drv.InstrumentSerialNumber(); err != nil {
+			return fmt.Errorf("error querying instrument sn: %w", err)
+		}
+		if id.Firmware, err = drv.FirmwareRevision(); err != nil {
+			return fmt.Errorf("error querying firmware revision: %w", err)
+		}
+		return nil
+	})
+	return id, err
+}
+
+func (s *server) reset(r *http.Request, _ empty) (empty, error) {
+	inst, err := s.lookup(r, "")
+	if err != nil {
+		return empty{}, err
+	}
+	return empty{}, inst.Do(r.Context(), func(drv bench.Inherent) error {
+		return drv.Reset()
+	})
+}
+
+// DC power supplies
+
+func (s *server) dcpwrChannels(r *http.Request, _ empty) (channelList, error) {
+	var list channelList
+	inst, err := s.lookup(r, bench.ClassDCPwr)
+	if err != nil {
+		return list, err
+	}
+	err = inst.Do(r.Context(), func(drv bench.Inherent) error {
+		ps := drv.(bench.DCPwr)
+		for i := range ps.OutputChannelCount() {
+			ch, err := ps.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return list, err
+}
+
+// withDCPwrChannel runs fn with exclusive access to the power supply output
+// named in the request path.
+func (s *server) withDCPwrChannel(r *http.Request, fn func(ch bench.DCPwrChannel) error) error {
+	inst, err := s.lookup(r, bench.ClassDCPwr)
+	if err != nil {
+		return err
+	}
+	i, err := channelIndex(r)
+	if err != nil {
+		return err
+	}
+	return inst.Do(r.Context(), func(drv bench.Inherent) error {
+		ch, err := drv.(bench.DCPwr).Channel(i)
+		if err != nil {
+			return &apiError{http.StatusNotFound, err}
+		}
+		return fn(ch)
+	})
+}
+
+func (s *server) getVoltage(r *http.Request, _ empty) (level, error) {
+	out := level{Unit: "V"}
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		var err error
+		out.Value, err = ch.VoltageLevel()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setVoltage(r *http.Request, req level) (level, error) {
+	out := level{Unit: "V"}
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		if err := ch.SetVoltageLevel(req.Value); err != nil {
+			return err
+		}
+		var err error
+		out.Value, err = ch.VoltageLevel()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) getCurrentLimit(r *http.Request, _ empty) (level, error) {
+	out := level{Unit: "A"}
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		var err error
+		out.Value, err = ch.CurrentLimit()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setCurrentLimit(r *http.Request, req level) (level, error) {
+	out := level{Unit: "A"}
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		if err := ch.SetCurrentLimit(req.Value); err != nil {
+			return err
+		}
+		var err error
+		out.Value, err = ch.CurrentLimit()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) getPSUOutput(r *http.Request, _ empty) (outputState, error) {
+	var out outputState
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		var err error
+		out.Enabled, err = ch.OutputEnabled()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setPSUOutput(r *http.Request, req outputState) (outputState, error) {
+	var out outputState
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		if err := ch.SetOutputEnabled(req.Enabled); err != nil {
+			return err
+		}
+		var err error
+		out.Enabled, err = ch.OutputEnabled()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) measurePSU(r *http.Request, _ empty) (psuMeasurement, error) {
+	var m psuMeasurement
+	err := s.withDCPwrChannel(r, func(ch bench.DCPwrChannel) error {
+		var err error
+		if m.Voltage, err = ch.MeasureVoltage(); err != nil {
+			return fmt.Errorf("error measuring voltage: %w", err)
+		}
+		if m.Current, err = ch.MeasureCurrent(); err != nil {
+			return fmt.Errorf("error measuring current: %w", err)
+		}
+		return nil
+	})
+	return m, err
+}
+
+// Digital multimeters
+
+// withDMM runs fn with exclusive access to the DMM named in the request path.
+func (s *server) withDMM(r *http.Request, fn func(d bench.DMM) error) error {
+	inst, err := s.lookup(r, bench.ClassDMM)
+	if err != nil {
+		return err
+	}
+	return inst.Do(r.Context(), func(drv bench.Inherent) error {
+		return fn(drv.(bench.DMM))
+	})
+}
+
+func (s *server) getFunction(r *http.Request, _ empty) (dmmFunction, error) {
+	var out dmmFunction
+	err := s.withDMM(r, func(d bench.DMM) error {
+		fcn, err := d.MeasurementFunction()
+		out.Function = fcn.String()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setFunction(r *http.Request, req dmmFunction) (dmmFunction, error) {
+	fcn, err := parseEnum[dmm.MeasurementFunction]("measurement function", req.Function)
+	if err != nil {
+		return dmmFunction{}, err
+	}
+	var out dmmFunction
+	err = s.withDMM(r, func(d bench.DMM) error {
+		if err := d.SetMeasurementFunction(fcn); err != nil {
+			return err
+		}
+		fcn, err := d.MeasurementFunction()
+		out.Function = fcn.String()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) getRange(r *http.Request, _ empty) (dmmRange, error) {
+	var out dmmRange
+	err := s.withDMM(r, func(d bench.DMM) error {
+		auto, rng, err := d.Range()
+		out.Auto, out.Range = auto.String(), rng
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setRange(r *http.Request, req dmmRange) (dmmRange, error) {
+	auto, err := parseEnum[dmm.AutoRange]("auto range", req.Auto)
+	if err != nil {
+		return dmmRange{}, err
+	}
+	var out dmmRange
+	err = s.withDMM(r, func(d bench.DMM) error {
+		if err := d.SetRange(auto, req.Range); err != nil {
+			return err
+		}
+		auto, rng, err := d.Range()
+		out.Auto, out.Range = auto.String(), rng
+		return err
+	})
+	return out, err
+}
+
+func (s *server) readDMM(r *http.Request, req readRequest) (dmmReading, error) {
+	maxTime, err := parseMaxTime(req.MaxTime)
+	if err != nil {
+		return dmmReading{}, err
+	}
+	var out dmmReading
+	err = s.withDMM(r, func(d bench.DMM) error {
+		fcn, err := d.MeasurementFunction()
+		if err != nil {
+			return fmt.Errorf("error querying the measurement function: %w", err)
+		}
+		out.Function = fcn.String()
+		out.Value, err = d.ReadMeasurement(maxTime)
+		return err
+	})
+	return out, err
+}
+
+// Function generators
+
+func (s *server) fgenChannels(r *http.Request, _ empty) (channelList, error) {
+	var list channelList
+	inst, err := s.lookup(r, bench.ClassFGen)
+	if err != nil {
+		return list, err
+	}
+	err = inst.Do(r.Context(), func(drv bench.Inherent) error {
+		fg := drv.(bench.FGen)
+		for i := range fg.ChannelCount() {
+			ch, err := fg.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return list, err
+}
+
+// withFGenChannel runs fn with exclusive access to the function generator
+// output named in the request path.
+func (s *server) withFGenChannel(r *http.Request, fn func(ch bench.FGenChannel) error) error {
+	inst, err := s.lookup(r, bench.ClassFGen)
+	if err != nil {
+		return err
+	}
+	i, err := channelIndex(r)
+	if err != nil {
+		return err
+	}
+	return inst.Do(r.Context(), func(drv bench.Inherent) error {
+		ch, err := drv.(bench.FGen).Channel(i)
+		if err != nil {
+			return &apiError{http.StatusNotFound, err}
+		}
+		return fn(ch)
+	})
+}
+
+func readWaveform(ch bench.FGenChannel) (waveform, error) {
+	var w waveform
+	wave, err := ch.StandardWaveform()
+	if err != nil {
+		return w, fmt.Errorf("error querying waveform: %w", err)
+	}
+	w.Waveform = wave.String()
+	if w.Frequency, err = ch.Frequency(); err != nil {
+		return w, fmt.Errorf("error querying frequency: %w", err)
+	}
+	if w.Amplitude, err = ch.Amplitude(); err != nil {
+		return w, fmt.Errorf("error querying amplitude: %w", err)
+	}
+	if w.Offset, err = ch.DCOffset(); err != nil {
+		return w, fmt.Errorf("error querying DC offset: %w", err)
+	}
+	return w, nil
+}
+
+func (s *server) getWaveform(r *http.Request, _ empty) (waveform, error) {
+	var out waveform
+	err := s.withFGenChannel(r, func(ch bench.FGenChannel) error {
+		var err error
+		out, err = readWaveform(ch)
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setWaveform(r *http.Request, req waveform) (waveform, error) {
+	wave, err := parseEnum[fgen.StandardWaveform]("waveform", req.Waveform)
+	if err != nil {
+		return waveform{}, err
+	}
+	var out waveform
+	err = s.withFGenChannel(r, func(ch bench.FGenChannel) error {
+		if err := ch.SetStandardWaveform(wave); err != nil {
+			return fmt.Errorf("error setting waveform: %w", err)
+		}
+		if err := ch.SetFrequency(req.Frequency); err != nil {
+			return fmt.Errorf("error setting frequency: %w", err)
+		}
+		if err := ch.SetAmplitude(req.Amplitude); err != nil {
+			return fmt.Errorf("error setting amplitude: %w", err)
+		}
+		if err := ch.SetDCOffset(req.Offset); err != nil {
+			return fmt.Errorf("error setting DC offset: %w", err)
+		}
+		var err error
+		out, err = readWaveform(ch)
+		return err
+	})
+	return out, err
+}
+
+func (s *server) getFGenOutput(r *http.Request, _ empty) (outputState, error) {
+	var out outputState
+	err := s.withFGenChannel(r, func(ch bench.FGenChannel) error {
+		var err error
+		out.Enabled, err = ch.OutputEnabled()
+		return err
+	})
+	return out, err
+}
+
+func (s *server) setFGenOutput(r *http.Request, req outputState) (outputState, error) {
+	var out outputState
+	err := s.withFGenChannel(r, func(ch bench.FGenChannel) error {
+		var err error
+		if req.Enabled {
+			err = ch.EnableOutput()
+		} else {
+			err = ch.DisableOutput()
+		}
+		if err != nil {
+			return err
+		}
+		out.Enabled, err = ch.OutputEnabled()
+		return err
+	})
+	return out, err
+}
+
+// Oscilloscopes
+
+func (s *server) scopeChannels(r *http.Request, _ empty) (channelList, error) {
+	var list channelList
+	inst, err := s.lookup(r, bench.ClassScope)
+	if err != nil {
+		return list, err
+	}
+	err = inst.Do(r.Context(), func(drv bench.Inherent) error {
+		sc := drv.(bench.Scope)
+		for i := range sc.ChannelCount() {
+			ch, err := sc.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return list, err
+}
+
+func (s *server) measureScope(r *http.Request, req scopeMeasureRequest) (scopeMeasurement, error) {
+	fcn, err := parseEnum[scope.MeasFunction]("measurement function", req.Function)
+	if err != nil {
+		return scopeMeasurement{}, err
+	}
+	maxTime, err := parseMaxTime(req.MaxTime)
+	if err != nil {
+		return scopeMeasurement{}, err
+	}
+	inst, err := s.lookup(r, bench.ClassScope)
+	if err != nil {
+		return scopeMeasurement{}, err
+	}
+	i, err := channelIndex(r)
+	if err != nil {
+		return scopeMeasurement{}, err
+	}
+	out := scopeMeasurement{Function: fcn.String()}
+	err = inst.Do(r.Context(), func(drv bench.Inherent) error {
+		ch, err := drv.(bench.Scope).Channel(i)
+		if err != nil {
+			return &apiError{http.StatusNotFound, err}
+		}
+		out.Value, err = ch.ReadWaveformMeasurement(fcn, maxTime)
+		return err
+	})
+	return out, err
+}
+
+// Switch matrices
+
+// withSwtch runs fn with exclusive access to the switch matrix named in the
+// request path.
+func (s *server) withSwtch(r *http.Request, fn func(sw bench.Swtch) error) error {
+	inst, err := s.lookup(r, bench.ClassSwtch)
+	if err != nil {
+		return err
+	}
+	return inst.Do(r.Context(), func(drv bench.Inherent) error {
+		return fn(drv.(bench.Swtch))
+	})
+}
+
+func (s *server) swtchChannels(r *http.Request, _ empty) (channelCount, error) {
+	var out channelCount
+	err := s.withSwtch(r, func(sw bench.Swtch) error {
+		out.Count = sw.ChannelCount()
+		return nil
+	})
+	return out, err
+}
+
+func checkConnection(req connection) error {
+	if req.Channel1 == "" || req.Channel2 == "" {
+		return badRequest("channel1 and channel2 are required")
+	}
+	return nil
+}
+
+func (s *server) connect(r *http.Request, req connection) (empty, error) {
+	if err := checkConnection(req); err != nil {
+		return empty{}, err
+	}
+	return empty{}, s.withSwtch(r, func(sw bench.Swtch) error {
+		return sw.Connect(req.Channel1, req.Channel2)
+	})
+}
+
+func (s *server) disconnect(r *http.Request, req connection) (empty, error) {
+	if err := checkConnection(req); err != nil {
+		return empty{}, err
+	}
+	return empty{}, s.withSwtch(r, func(sw bench.Swtch) error {
+		return sw.Disconnect(req.Channel1, req.Channel2)
+	})
+}
+
+func (s *server) disconnectAll(r *http.Request, _ empty) (empty, error) {
+	return empty{}, s.withSwtch(r, func(sw bench.Swtch) error {
+		return sw.DisconnectAll()
+	})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+)
+
+func main() {
+	log.Println("IVI Bench HTTP Server Application")
+
+	var (
+		configFile string
+		addr       string
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments to serve",
+	)
+	flag.StringVar(&addr, "addr", ":8080", "Address to listen on")
+	limitsFile := limits.Flag()
+	flag.Parse()
+
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		log.Fatalf("error opening bench: %s", err)
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	if err := limits.ApplyFile(b, *limitsFile); err != nil {
+		log.Fatal(err)
+	}
+	for _, inst := range b.Instruments() {
+		log.Printf("Serving %s %s (%s) at %s", inst.Class, inst.Name, inst.Driver, inst.Address)
+	}
+
+	// Requests for the same instrument are serialized by bench.Instrument.Do,
+	// while requests for different instruments run concurrently.
+	s := server{bench: b}
+	endpoints := s.endpoints()
+	mux := http.NewServeMux()
+	for _, e := range endpoints {
+		mux.HandleFunc(e.method+" "+e.pattern, e.handler)
+	}
+	doc := openAPI("IVI Bench", "1.0.0", endpoints)
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, doc)
+	})
+
+	srv := http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		log.Println("Shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("error shutting down: %s", err)
+		}
+	}()
+
+	log.Printf("Listening on %s; API described at /openapi.json", addr)
+	if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("HTTP server error: %s", err)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// object is a JSON object in the OpenAPI document.
+type object = map[string]any
+
+// pathParam matches the wildcards in a ServeMux pattern.
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// openAPI builds an OpenAPI 3.1 document describing the endpoints. The JSON
+// schemas of the request and response bodies are generated from the Go types
+// using reflection, with field descriptions taken from the doc struct tag.
+func openAPI(title, version string, endpoints []endpoint) object {
+	schemas := object{
+		"Error": schemaOf(reflect.TypeFor[errorResponse](), nil),
+	}
+	paths := object{}
+	for _, e := range endpoints {
+		item, ok := paths[e.pattern].(object)
+		if !ok {
+			item = object{}
+			paths[e.pattern] = item
+		}
+		op := object{
+			"summary":     e.summary,
+			"operationId": operationID(e.method, e.pattern),
+			"tags":        []string{e.tag},
+			"responses": object{
+				"200": object{
+					"description": "Success",
+					"content": object{
+						"application/json": object{"schema": schemaOf(e.resp, schemas)},
+					},
+				},
+				"default": object{
+					"description": "Error",
+					"content": object{
+						"application/json": object{"schema": ref("Error")},
+					},
+				},
+			},
+		}
+		var params []object
+		for _, m := range pathParam.FindAllStringSubmatch(e.pattern, -1) {
+			schema := object{"type": "string"}
+			if m[1] == "ch" {
+				schema = object{"type": "integer", "minimum": 0}
+			}
+			params = append(params, object{
+				"name":     m[1],
+				"in":       "path",
+				"required": true,
+				"schema":   schema,
+			})
+		}
+		if params != nil {
+			op["parameters"] = params
+		}
+		if e.req != nil {
+			op["requestBody"] = object{
+				"required": e.method != "POST",
+				"content": object{
+					"application/json": object{"schema": schemaOf(e.req, schemas)},
+				},
+			}
+		}
+		item[strings.ToLower(e.method)] = op
+	}
+	paths["/openapi.json"] = object{
+		"get": object{
+			"summary":     "Get this OpenAPI document",
+			"operationId": "getOpenAPI",
+			"responses": object{
+				"200": object{"description": "OpenAPI document"},
+			},
+		},
+	}
+	return object{
+		"openapi":    "3.1.0",
+		"info":       object{"title": title, "version": version},
+		"paths":      paths,
+		"components": object{"schemas": schemas},
+	}
+}
+
+func ref(name string) object {
+	return object{"$ref": "#/components/schemas/" + name}
+}
+
+// schemaOf returns the JSON schema of t. Named struct types are added to
+// schemas and referenced; with a nil schemas map they're inlined.
+func schemaOf(t reflect.Type, schemas object) object {
+	switch t.Kind() {
+	case reflect.Bool:
+		return object{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return object{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return object{"type": "number"}
+	case reflect.String:
+		return object{"type": "string"}
+	case reflect.Pointer:
+		return schemaOf(t.Elem(), schemas)
+	case reflect.Slice, reflect.Array:
+		return object{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case reflect.Map:
+		return object{"type": "object", "additionalProperties": schemaOf(t.Elem(), schemas)}
+	case reflect.Struct:
+		name := schemaName(t)
+		if schemas == nil || name == "" {
+			return structSchema(t, schemas)
+		}
+		if _, ok := schemas[name]; !ok {
+			schemas[name] = object{} // placeholder in case of recursion
+			schemas[name] = structSchema(t, schemas)
+		}
+		return ref(name)
+	}
+	return object{}
+}
+
+func structSchema(t reflect.Type, schemas object) object {
+	props := object{}
+	var required []string
+	for f := range t.Fields() {
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		prop := schemaOf(f.Type, schemas)
+		if doc := f.Tag.Get("doc"); doc != "" {
+			if _, isRef := prop["$ref"]; isRef {
+				prop = object{"allOf": []object{prop}}
+			}
+			prop["description"] = doc
+		}
+		props[name] = prop
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			required = append(required, name)
+		}
+	}
+	s := object{"type": "object", "properties": props, "additionalProperties": false}
+	if required != nil {
+		s["required"] = required
+	}
+	return s
+}
+
+// schemaName turns a Go type name such as psuMeasurement into PsuMeasurement.
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if name == "" {
+		return ""
+	}
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// operationID turns a method and pattern such as PUT
+// /dcpwr/{name}/channels/{ch}/voltage into putDcpwrChannelsVoltage. A
+// trailing wildcard adds By and its name, so GET /instruments/{name} becomes
+// getInstrumentsByName.
+func operationID(method, pattern string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	segs := strings.Split(pattern, "/")
+	for i, seg := range segs {
+		if seg == "" {
+			continue
+		}
+		if strings.HasPrefix(seg, "{") {
+			if i == len(segs)-1 {
+				name := strings.Trim(seg, "{}")
+				b.WriteString("By" + strings.ToUpper(name[:1]) + name[1:])
+			}
+			continue
+		}
+		for part := range strings.SplitSeq(seg, "-") {
+			if part == "" {
+				continue
+			}
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
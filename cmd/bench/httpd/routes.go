@@ -0,0 +1,136 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/limits"
+)
+
+// maxBody limits the size of request bodies.
+const maxBody = 1 << 20
+
+// empty is used as the request type of endpoints without a body.
+type empty struct{}
+
+// endpoint is one route of the API. The request and response types are kept
+// so the OpenAPI document can be generated from the same table that builds
+// the mux.
+type endpoint struct {
+	method  string
+	pattern string
+	tag     string
+	summary string
+	req     reflect.Type // nil when the endpoint takes no body
+	resp    reflect.Type
+	handler http.HandlerFunc
+}
+
+// newEndpoint creates an endpoint that decodes the JSON request body into a
+// Req, calls fn, and encodes the returned Resp as JSON. POST bodies are
+// optional, but PUT bodies are required so a missing body doesn't set a value
+// to zero.
+func newEndpoint[Req, Resp any](
+	method, pattern, tag, summary string,
+	fn func(r *http.Request, req Req) (Resp, error),
+) endpoint {
+	e := endpoint{
+		method:  method,
+		pattern: pattern,
+		tag:     tag,
+		summary: summary,
+		resp:    reflect.TypeFor[Resp](),
+	}
+	if t := reflect.TypeFor[Req](); t != reflect.TypeFor[empty]() {
+		e.req = t
+	}
+	e.handler = func(w http.ResponseWriter, r *http.Request) {
+		var req Req
+		if e.req != nil {
+			dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody))
+			dec.DisallowUnknownFields()
+			err := dec.Decode(&req)
+			if err != nil && !(errors.Is(err, io.EOF) && method == http.MethodPost) {
+				writeError(w, r, badRequest("invalid request body: %s", err))
+				return
+			}
+		}
+		resp, err := fn(r, req)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	}
+	return e
+}
+
+// apiError is an error with the HTTP status to report it with.
+type apiError struct {
+	status int
+	err    error
+}
+
+func (e *apiError) Error() string { return e.err.Error() }
+func (e *apiError) Unwrap() error { return e.err }
+
+func badRequest(format string, a ...any) error {
+	return &apiError{http.StatusBadRequest, fmt.Errorf(format, a...)}
+}
+
+func notFound(format string, a ...any) error {
+	return &apiError{http.StatusNotFound, fmt.Errorf(format, a...)}
+}
+
+// errorResponse is the body of every error response.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// statusOf maps an error to an HTTP status. Setpoints rejected by the limits
+// are reported as 422 Unprocessable Entity. Errors from the instrument are
+// reported as 502 Bad Gateway since the server is a gateway to it.
+func statusOf(err error) int {
+	var ae *apiError
+	switch {
+	case errors.As(err, &ae):
+		return ae.status
+	case errors.Is(err, limits.ErrLimitExceeded):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ivi.ErrFunctionNotSupported), errors.Is(err, ivi.ErrNotImplemented):
+		return http.StatusNotImplemented
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadGateway
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := statusOf(err)
+	if status >= http.StatusInternalServerError {
+		log.Printf("%s %s: %s", r.Method, r.URL.Path, err)
+	}
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("error writing response: %s", err)
+	}
+}
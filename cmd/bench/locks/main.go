@@ -0,0 +1,142 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	"github.com/gotmc/visa"
+)
+
+func main() {
+	log.Println("IVI Bench Instrument Locks Application")
+
+	var (
+		configFile string
+		dir        string
+		scpi       string
+		timeout    time.Duration
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"",
+		"JSON bench config whose instruments to show (empty = all held locks)",
+	)
+	flag.StringVar(&dir, "dir", instlock.Dir(), "Lock directory (also set by $"+instlock.DirEnv+")")
+	flag.StringVar(
+		&scpi,
+		"scpi",
+		"",
+		"VISA address of a Keysight instrument to ask for its SYSTem:LOCK owner",
+	)
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "I/O timeout for -scpi")
+	flag.Parse()
+
+	addresses := flag.Args()
+	if configFile != "" {
+		cfg, err := bench.LoadConfig(configFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, ic := range cfg.Instruments {
+			// The instruments behind a Prologix controller share the lock of
+			// its serial port.
+			addr := ic.Address
+			if ic.Prologix != nil {
+				addr = ic.Prologix.Port
+			}
+			if !slices.Contains(addresses, addr) {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+
+	var err error
+	if len(addresses) == 0 {
+		err = listHeld(os.Stdout, dir)
+	} else {
+		err = showAddresses(os.Stdout, dir, addresses)
+	}
+	if scpi != "" {
+		err = errors.Join(err, showSCPIOwner(os.Stdout, scpi, timeout))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// listHeld prints every held lock in dir.
+func listHeld(w io.Writer, dir string) error {
+	holders, err := instlock.List(instlock.WithDir(dir))
+	if len(holders) == 0 {
+		fmt.Fprintf(w, "No instruments are locked in %s\n", dir)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Address\tHolder\t")
+	for _, h := range holders {
+		fmt.Fprintf(tw, "%s\t%s\t\n", h.Address, h)
+	}
+	tw.Flush()
+	return err
+}
+
+// showAddresses prints whether each address is locked and by whom.
+func showAddresses(w io.Writer, dir string, addresses []string) error {
+	var errs []error
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Address\tHolder\t")
+	for _, addr := range addresses {
+		h, err := instlock.Query(addr, instlock.WithDir(dir))
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+			fmt.Fprintf(tw, "%s\terror: %s\t\n", addr, err)
+		case h == nil:
+			fmt.Fprintf(tw, "%s\tfree\t\n", addr)
+		default:
+			fmt.Fprintf(tw, "%s\t%s\t\n", addr, h)
+		}
+	}
+	tw.Flush()
+	return errors.Join(errs...)
+}
+
+// showSCPIOwner prints the interface holding the instrument's own lock. The
+// instrument is opened without taking its lock file, so the owner can be
+// queried while another process holds it. The VISA drivers are registered by
+// the bench package.
+func showSCPIOwner(w io.Writer, address string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	t, err := visa.NewResource(ctx, address)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", address, err)
+	}
+	defer func() {
+		if err := t.Close(); err != nil {
+			log.Printf("error closing %s: %s", address, err)
+		}
+	}()
+	owner, err := instlock.OwnerSCPI(ctx, t)
+	if err != nil {
+		return fmt.Errorf("error querying the lock owner of %s: %w", address, err)
+	}
+	fmt.Fprintf(w, "\n%s SYSTem:LOCK owner: %s\n", address, owner)
+	return nil
+}
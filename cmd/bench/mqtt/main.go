@@ -0,0 +1,161 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/mqttbridge"
+	"github.com/gotmc/ivi/scope"
+)
+
+func main() {
+	log.Println("IVI Bench MQTT Bridge Application")
+
+	var (
+		configFile string
+		broker     string
+		listen     string
+		clientID   string
+		interval   time.Duration
+		maxTime    time.Duration
+		scopeChs   string
+		scopeMeas  string
+		topics     mqttbridge.Topics
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments to bridge",
+	)
+	flag.StringVar(
+		&broker,
+		"broker",
+		"",
+		"MQTT broker URL, e.g., tcp://localhost:1883 (empty = run an in-process broker)",
+	)
+	flag.StringVar(
+		&listen,
+		"listen",
+		":1883",
+		"Address the in-process broker listens on (empty = no network clients)",
+	)
+	flag.StringVar(&clientID, "client-id", "ivi-bench", "MQTT client ID")
+	flag.DurationVar(&interval, "interval", 5*time.Second, "Time between polls of each instrument")
+	flag.DurationVar(&maxTime, "maxtime", 2*time.Second, "Maximum time for a reading")
+	flag.StringVar(&scopeChs, "scope-ch", "0", "Comma-separated 0-based scope channels to measure")
+	flag.StringVar(
+		&scopeMeas,
+		"scope-meas",
+		"",
+		"Comma-separated scope measurements, e.g., Frequency,VoltagePeakToPeak",
+	)
+	flag.StringVar(&topics.Reading, "reading-topic", mqttbridge.DefaultTopics.Reading, "Reading topic")
+	flag.StringVar(&topics.Event, "event-topic", mqttbridge.DefaultTopics.Event, "Event topic")
+	flag.StringVar(&topics.Command, "command-topic", mqttbridge.DefaultTopics.Command, "Command topic")
+	limitsFile := limits.Flag()
+	flag.Parse()
+
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	opts := []mqttbridge.Option{
+		mqttbridge.WithTopics(topics),
+		mqttbridge.WithInterval(interval),
+		mqttbridge.WithMaxTime(maxTime),
+	}
+	if scopeMeas != "" {
+		chs, fcns, err := parseScope(scopeChs, scopeMeas)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts = append(opts, mqttbridge.WithScopeMeasurements(chs, fcns...))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		log.Fatalf("error opening bench: %s", err)
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	if err := limits.ApplyFile(b, *limitsFile); err != nil {
+		log.Fatal(err)
+	}
+
+	var client mqttbridge.Client
+	if broker == "" {
+		brk, err := mqttbridge.NewBroker(listen)
+		if err != nil {
+			log.Fatalf("error starting broker: %s", err)
+		}
+		defer func() {
+			if err := brk.Close(); err != nil {
+				log.Printf("error closing broker: %s", err)
+			}
+		}()
+		log.Printf("Running in-process MQTT broker on %s", listen)
+		client = brk.Client()
+	} else {
+		client, err = mqttbridge.NewPahoClient(openCtx, broker, clientID)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Connected to MQTT broker %s", broker)
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.Printf("error closing MQTT client: %s", err)
+		}
+	}()
+
+	for _, inst := range b.Instruments() {
+		log.Printf("Bridging %s %s (%s) at %s", inst.Class, inst.Name, inst.Driver, inst.Address)
+	}
+	if err := mqttbridge.New(b, client, opts...).Run(ctx); err != nil {
+		log.Printf("error running bridge: %s", err)
+	}
+	log.Println("Shutting down")
+}
+
+// parseScope parses the scope channel and measurement lists.
+func parseScope(chs, meas string) ([]int, []scope.MeasFunction, error) {
+	var channels []int
+	for s := range strings.SplitSeq(chs, ",") {
+		ch, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return nil, nil, err
+		}
+		channels = append(channels, ch)
+	}
+	var fcns []scope.MeasFunction
+	for s := range strings.SplitSeq(meas, ",") {
+		fcn, err := bench.ParseEnum[scope.MeasFunction](strings.TrimSpace(s))
+		if err != nil {
+			return nil, nil, err
+		}
+		fcns = append(fcns, fcn)
+	}
+	return channels, fcns, nil
+}
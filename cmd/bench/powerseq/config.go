@@ -0,0 +1,80 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+// sequenceConfig lists the rails in power-up order.
+type sequenceConfig struct {
+	Rails []railConfig `json:"rails"`
+}
+
+// railConfig describes one rail. Instrument is the name of a DC power
+// supply in the bench config and Channel the name of its output; an empty
+// Channel selects the first output.
+type railConfig struct {
+	Name       string         `json:"name"`
+	Instrument string         `json:"instrument"`
+	Channel    string         `json:"channel,omitempty"`
+	Voltage    float64        `json:"voltage"`
+	Current    float64        `json:"current"`
+	OVP        float64        `json:"ovp,omitempty"`
+	Tolerance  float64        `json:"tolerance,omitempty"`
+	Timeout    bench.Duration `json:"timeout,omitzero"`
+	Delay      bench.Duration `json:"delay,omitzero"`
+}
+
+func loadSequence(path string) (sequenceConfig, error) {
+	var cfg sequenceConfig
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if err = cfg.validate(); err != nil {
+		return cfg, fmt.Errorf("invalid sequence %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (c sequenceConfig) validate() error {
+	if len(c.Rails) == 0 {
+		return errors.New("no rails")
+	}
+	var errs []error
+	seen := make(map[string]bool)
+	for i, r := range c.Rails {
+		switch {
+		case r.Name == "":
+			errs = append(errs, fmt.Errorf("rail %d: missing name", i))
+		case seen[r.Name]:
+			errs = append(errs, fmt.Errorf("rail %q: duplicate name", r.Name))
+		}
+		seen[r.Name] = true
+		if r.Instrument == "" {
+			errs = append(errs, fmt.Errorf("rail %q: missing instrument", r.Name))
+		}
+		if r.Voltage <= 0 || r.Current <= 0 {
+			errs = append(errs, fmt.Errorf("rail %q: voltage and current must be positive", r.Name))
+		}
+		if r.OVP != 0 && r.OVP <= r.Voltage {
+			errs = append(errs, fmt.Errorf("rail %q: OVP %g V must be above the voltage", r.Name, r.OVP))
+		}
+		if r.Tolerance < 0 || r.Timeout.Duration < 0 || r.Delay.Duration < 0 {
+			errs = append(errs, fmt.Errorf("rail %q: negative tolerance, timeout, or delay", r.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/sequence"
+)
+
+func main() {
+	log.Println("IVI Bench Power Sequencer Application")
+
+	var (
+		configFile   string
+		sequenceFile string
+		hold         time.Duration
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the power supplies",
+	)
+	flag.StringVar(
+		&sequenceFile,
+		"sequence",
+		"sequence.json",
+		"JSON file listing the rails in power-up order",
+	)
+	flag.DurationVar(
+		&hold,
+		"hold",
+		0,
+		"Time to keep the rails up before powering down (0 = until interrupted)",
+	)
+	limitsFile := limits.Flag()
+	flag.Parse()
+
+	if err := run(configFile, sequenceFile, *limitsFile, hold); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run brings the rails up, holds them, and tears them down again. The rails
+// are torn down in reverse order whether the power-up succeeds, fails, or is
+// interrupted.
+func run(configFile, sequenceFile, limitsFile string, hold time.Duration) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+	seqCfg, err := loadSequence(sequenceFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	if err := limits.ApplyFile(b, limitsFile); err != nil {
+		return err
+	}
+
+	rails := make([]sequence.Rail, len(seqCfg.Rails))
+	for i, r := range seqCfg.Rails {
+		out, err := newOutput(openCtx, b, r.Instrument, r.Channel)
+		if err != nil {
+			return err
+		}
+		rails[i] = sequence.Rail{
+			Name:         r.Name,
+			Output:       out,
+			Voltage:      r.Voltage,
+			CurrentLimit: r.Current,
+			OVP:          r.OVP,
+			Tolerance:    r.Tolerance,
+			Timeout:      r.Timeout.Duration,
+			Delay:        r.Delay.Duration,
+		}
+	}
+
+	seq := sequence.New(rails)
+	if err := seq.Up(ctx); err != nil {
+		return err
+	}
+	if hold > 0 {
+		log.Printf("All rails up; holding for %s", hold)
+		select {
+		case <-time.After(hold):
+		case <-ctx.Done():
+		}
+	} else {
+		log.Println("All rails up; press Ctrl-C to power down")
+		<-ctx.Done()
+	}
+
+	// Keep the power-down delays after an interrupt.
+	return seq.Down(context.WithoutCancel(ctx))
+}
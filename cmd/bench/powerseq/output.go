@@ -0,0 +1,69 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+// output is a power supply channel used through bench.Instrument.Do. It
+// implements sequence.Output.
+type output struct {
+	inst *bench.Instrument
+	ch   int
+}
+
+// newOutput looks up the named channel of a DC power supply. An empty name
+// selects the first channel.
+func newOutput(ctx context.Context, b *bench.Bench, instrument, channel string) (*output, error) {
+	inst, ok := b.Instrument(instrument)
+	if !ok {
+		return nil, fmt.Errorf("no instrument %q in the bench config", instrument)
+	}
+	ch, err := inst.DCPwrChannelIndex(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+	return &output{inst: inst, ch: ch}, nil
+}
+
+func (o *output) do(fn func(ch bench.DCPwrChannel) error) error {
+	return o.inst.Do(context.Background(), func(drv bench.Inherent) error {
+		ch, err := drv.(bench.DCPwr).Channel(o.ch)
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (o *output) SetVoltageLevel(v float64) error {
+	return o.do(func(ch bench.DCPwrChannel) error { return ch.SetVoltageLevel(v) })
+}
+
+func (o *output) SetCurrentLimit(a float64) error {
+	return o.do(func(ch bench.DCPwrChannel) error { return ch.SetCurrentLimit(a) })
+}
+
+func (o *output) ConfigureOVP(enabled bool, limit float64) error {
+	return o.do(func(ch bench.DCPwrChannel) error { return ch.ConfigureOVP(enabled, limit) })
+}
+
+func (o *output) SetOutputEnabled(b bool) error {
+	return o.do(func(ch bench.DCPwrChannel) error { return ch.SetOutputEnabled(b) })
+}
+
+func (o *output) MeasureVoltage() (float64, error) {
+	var v float64
+	err := o.do(func(ch bench.DCPwrChannel) (err error) {
+		v, err = ch.MeasureVoltage()
+		return err
+	})
+	return v, err
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/rack"
+)
+
+func main() {
+	log.Println("IVI Bench Rack Startup Application")
+
+	var (
+		configFile string
+		reset      bool
+		timeout    time.Duration
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments to open",
+	)
+	flag.BoolVar(&reset, "reset", false, "Reset every instrument after clearing it")
+	flag.DurationVar(&timeout, "timeout", 30*time.Second, "Timeout for each setup step")
+	flag.Parse()
+
+	if err := run(configFile, reset, timeout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(configFile string, reset bool, timeout time.Duration) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	start := time.Now()
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	log.Printf("Opened %d instruments in %s", len(b.Instruments()), time.Since(start))
+
+	// Each instrument is cleared, optionally reset, and then identified. The
+	// steps of different instruments don't depend on each other, so they run
+	// in parallel.
+	var (
+		steps []rack.Step
+		ids   = make([]string, len(b.Instruments()))
+	)
+	for i, inst := range b.Instruments() {
+		cleared := inst.Name + " clear"
+		steps = append(steps, rack.Step{
+			Name:       cleared,
+			Instrument: inst.Name,
+			Timeout:    timeout,
+			Fn:         func(_ context.Context, drv bench.Inherent) error { return drv.Clear() },
+		})
+		prev := cleared
+		if reset {
+			prev = inst.Name + " reset"
+			steps = append(steps, rack.Step{
+				Name:       prev,
+				Instrument: inst.Name,
+				After:      []string{cleared},
+				Timeout:    timeout,
+				Fn:         func(_ context.Context, drv bench.Inherent) error { return drv.Reset() },
+			})
+		}
+		steps = append(steps, rack.Step{
+			Name:       inst.Name + " identify",
+			Instrument: inst.Name,
+			After:      []string{prev},
+			Timeout:    timeout,
+			Fn: func(_ context.Context, drv bench.Inherent) error {
+				mfr, err := drv.InstrumentManufacturer()
+				if err != nil {
+					return err
+				}
+				model, err := drv.InstrumentModel()
+				if err != nil {
+					return err
+				}
+				ids[i] = mfr + " " + model
+				return nil
+			},
+		})
+	}
+
+	start = time.Now()
+	results, err := rack.New(b).Run(ctx, steps)
+	printResults(os.Stdout, b, ids, results, start)
+	return err
+}
+
+// printResults prints the identity of each instrument and the timing of each
+// step, relative to start.
+func printResults(w io.Writer, b *bench.Bench, ids []string, results []rack.Result, start time.Time) {
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Instrument\tDriver\tAddress\tIdentity\t")
+	for i, inst := range b.Instruments() {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", inst.Name, inst.Driver, inst.Address, ids[i])
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	var (
+		total   time.Duration
+		elapsed time.Duration
+	)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Step\tStarted\tDuration\tResult\t")
+	for _, r := range results {
+		if r.Start.IsZero() {
+			fmt.Fprintf(tw, "%s\t-\t-\t%s\t\n", r.Step, r.Err)
+			continue
+		}
+		result := "OK"
+		if r.Err != nil {
+			result = r.Err.Error()
+		}
+		offset := r.Start.Sub(start)
+		fmt.Fprintf(tw, "%s\t+%s\t%s\t%s\t\n",
+			r.Step, offset.Round(time.Millisecond), r.Duration.Round(time.Millisecond), result)
+		total += r.Duration
+		elapsed = max(elapsed, offset+r.Duration)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\nSetup took %s for %s of instrument time\n\n",
+		elapsed.Round(time.Millisecond), total.Round(time.Millisecond))
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/report"
+	"github.com/gotmc/ivi-examples/internal/script"
+)
+
+var errFailed = errors.New("procedure failed")
+
+func main() {
+	log.Println("IVI Bench Starlark Procedure Application")
+
+	var (
+		configFile string
+		jsonFile   string
+		junitFile  string
+		htmlFile   string
+	)
+	flag.StringVar(
+		&configFile,
+		"config",
+		"bench.json",
+		"JSON file listing the instruments",
+	)
+	flag.StringVar(
+		&jsonFile,
+		"json",
+		"",
+		"File to write the results to as JSON (empty = none)",
+	)
+	flag.StringVar(
+		&junitFile,
+		"junit",
+		"",
+		"File to write a JUnit XML report to for CI (empty = none)",
+	)
+	flag.StringVar(
+		&htmlFile,
+		"html",
+		"",
+		"File to write an HTML report to (empty = none)",
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] procedure.star...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	limitsFile := limits.Flag()
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	out := outputs{json: jsonFile, junit: junitFile, html: htmlFile}
+	err := run(configFile, *limitsFile, out, flag.Args())
+	if errors.Is(err, errFailed) {
+		log.Println("FAIL")
+		os.Exit(1)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("PASS")
+}
+
+// outputs are the files to write the results to.
+type outputs struct {
+	json, junit, html string
+}
+
+// run runs the procedures in order, stopping early only if interrupted.
+func run(configFile, limitsFile string, out outputs, files []string) error {
+	cfg, err := bench.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	openCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b, err := bench.Open(openCtx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.Close(); err != nil {
+			log.Printf("error closing bench: %s", err)
+		}
+	}()
+	if err := limits.ApplyFile(b, limitsFile); err != nil {
+		return err
+	}
+
+	rep := report.New("Bench procedures")
+	if out.junit != "" || out.html != "" {
+		if rep.Instruments, err = report.Identify(ctx, b); err != nil {
+			log.Printf("error identifying instruments: %s", err)
+		}
+	}
+
+	runner := script.New(b)
+	var results []*script.Result
+	for _, file := range files {
+		if ctx.Err() != nil {
+			break
+		}
+		log.Printf("Running %s", file)
+		res := runner.Run(ctx, file, nil)
+		results = append(results, res)
+		logResult(addProcedure(rep, res))
+	}
+
+	if err := writeOutputs(out, results, rep); err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if !rep.Passed() {
+		return errFailed
+	}
+	return nil
+}
+
+// logResult logs the steps and outcome of a procedure.
+func logResult(p *report.Procedure) {
+	for _, s := range p.Steps {
+		log.Printf("  %s  %-30s %-14s %s", measure.Verdict(s.Pass), s.Name, s.Measurement(), s.Limits())
+	}
+	if p.Err != nil {
+		log.Printf("  ERROR %s", p.Err)
+	}
+	log.Printf("%s %s: %d steps, %d failed in %s",
+		measure.Verdict(p.Passed()), p.Name, len(p.Steps), p.Failures(), p.Duration.Round(time.Millisecond))
+}
+
+// jsonResult adds the error message and verdict to a result.
+type jsonResult struct {
+	*script.Result
+	Error  string `json:"error,omitempty"`
+	Passed bool   `json:"passed"`
+}
+
+// addProcedure adds the result of a procedure to a report.
+func addProcedure(rep *report.Report, res *script.Result) *report.Procedure {
+	p := report.Procedure{Name: res.File, Start: res.Start, Duration: res.Duration, Err: res.Err}
+	for _, s := range res.Steps {
+		p.Steps = append(p.Steps, report.Step{
+			Name:  s.Name,
+			Value: s.Value,
+			Min:   s.Min,
+			Max:   s.Max,
+			Unit:  s.Unit,
+			Pass:  s.Pass,
+			Time:  s.Time,
+		})
+	}
+	rep.Procedures = append(rep.Procedures, &p)
+	return &p
+}
+
+func writeOutputs(out outputs, results []*script.Result, rep *report.Report) error {
+	var errs []error
+	if out.json != "" {
+		errs = append(errs, writeJSON(out.json, results))
+	}
+	errs = append(errs, rep.WriteFiles(out.html, out.junit))
+	return errors.Join(errs...)
+}
+
+func writeJSON(path string, results []*script.Result) error {
+	out := make([]jsonResult, len(results))
+	for i, res := range results {
+		out[i] = jsonResult{Result: res, Passed: res.Passed()}
+		if res.Err != nil {
+			out[i].Error = res.Err.Error()
+		}
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
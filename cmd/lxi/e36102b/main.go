@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/dcpwr"
 	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
 	"github.com/gotmc/lxi"
@@ -36,20 +38,30 @@ func main() {
 		5*time.Second,
 		"I/O timeout applied to each instrument operation",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
+	ctx := context.Background()
+
 	// Bound the initial TCP dial with the same timeout so an unreachable
 	// instrument fails fast instead of hanging on the default OS connect
 	// timeout.
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	dialCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Create a new LXI device. The E36100B series listens for raw SCPI socket
-	// sessions on TCP port 5025.
 	address := fmt.Sprintf("TCPIP0::%s::5025::SOCKET", ip)
 	log.Printf("VISA address = %s", address)
 	log.Printf("I/O timeout = %s", timeout)
-	dev, err := lxi.NewDevice(ctx, address)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Create a new LXI device. The E36100B series listens for raw SCPI socket
+	// sessions on TCP port 5025.
+	dev, err := lxi.NewDevice(dialCtx, address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
 	}
@@ -118,9 +130,19 @@ func main() {
 	}
 	log.Printf("Configuring channel %q", ch.Name())
 
+	// The driver methods only fail on I/O errors, so drain the supply's
+	// SYST:ERR? queue after each configuration step to catch settings the
+	// supply rejected. WithStrict turns any queued error into a step failure.
+	chk := errqueue.NewChecker(
+		dev,
+		errqueue.SCPI,
+		errqueue.WithStrict(),
+		errqueue.WithTimeout(timeout),
+	)
+
 	// Turn the output off while configuring it.
-	if err = ch.DisableOutput(); err != nil {
-		log.Fatalf("error disabling output: %s", err)
+	if err = chk.Step(ctx, "disable output", ch.DisableOutput); err != nil {
+		log.Fatal(err)
 	}
 
 	// Set the output to 3.3 V and regulate at a 0.5 A current limit rather
@@ -130,11 +152,15 @@ func main() {
 		currentLimit   = 0.5
 		ovpLimit       = 4.0
 	)
-	if err = ch.SetVoltageLevel(desiredVoltage); err != nil {
-		log.Fatalf("error setting voltage level: %s", err)
+	if err = chk.Step(ctx, "set voltage level", func() error {
+		return ch.SetVoltageLevel(desiredVoltage)
+	}); err != nil {
+		log.Fatal(err)
 	}
-	if err = ch.ConfigureCurrentLimit(dcpwr.CurrentRegulate, currentLimit); err != nil {
-		log.Fatalf("error configuring current limit: %s", err)
+	if err = chk.Step(ctx, "configure current limit", func() error {
+		return ch.ConfigureCurrentLimit(dcpwr.CurrentRegulate, currentLimit)
+	}); err != nil {
+		log.Fatal(err)
 	}
 	// The above call is the same as the following two:
 	// ch.SetCurrentLimitBehavior(dcpwr.CurrentRegulate)
@@ -142,12 +168,14 @@ func main() {
 
 	// Arm over-voltage protection above the programmed level so the supply
 	// shuts the output down if it ever runs away.
-	if err = ch.ConfigureOVP(true, ovpLimit); err != nil {
-		log.Fatalf("error configuring OVP: %s", err)
+	if err = chk.Step(ctx, "configure OVP", func() error {
+		return ch.ConfigureOVP(true, ovpLimit)
+	}); err != nil {
+		log.Fatal(err)
 	}
 
-	if err = ch.EnableOutput(); err != nil {
-		log.Fatalf("error enabling output: %s", err)
+	if err = chk.Step(ctx, "enable output", ch.EnableOutput); err != nil {
+		log.Fatal(err)
 	}
 
 	// Let the power supply settle before measuring the output.
@@ -12,6 +12,7 @@ import (
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/keysight/kt33000"
 	"github.com/gotmc/lxi"
@@ -28,13 +29,21 @@ func main() {
 		"192.168.1.100",
 		"IP address of Keysight 33220A",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	ctx := context.Background()
 
-	// Create a new LXI device
 	address := fmt.Sprintf("TCPIP0::%s::5025::SOCKET", ip)
 	log.Printf("VISA address = %s", address)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Create a new LXI device
 	dev, err := lxi.NewDevice(ctx, address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
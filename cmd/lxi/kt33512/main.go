@@ -12,6 +12,8 @@ import (
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/keysight/kt33000"
 	"github.com/gotmc/lxi"
@@ -20,21 +22,36 @@ import (
 func main() {
 	log.Println("IVI LXI Keysight 33512B Example Application")
 
-	// Get IP address from CLI flag.
+	// Get IP address and channel 2 phase offset from CLI flags.
 	var ip string
+	var phase float64
 	flag.StringVar(
 		&ip,
 		"ip",
 		"192.168.1.100",
 		"IP address of Keysight 33512B",
 	)
+	flag.Float64Var(
+		&phase,
+		"phase",
+		90.0,
+		"Phase offset of channel 2 relative to channel 1 in degrees",
+	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	ctx := context.Background()
 
-	// Create a new LXI device
 	address := fmt.Sprintf("TCPIP0::%s::5025::SOCKET", ip)
 	log.Printf("VISA address = %s", address)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Create a new LXI device
 	dev, err := lxi.NewDevice(ctx, address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
@@ -148,16 +165,44 @@ func main() {
 	}
 
 	// Wait for the instrument to finish processing all configuration commands,
-	// then clear any settings conflict warnings from the error queue.
+	// then report any settings conflict warnings left in the error queue.
 	if _, err = dev.Query(ctx, "*OPC?"); err != nil {
 		log.Printf("error waiting for operation complete: %s", err)
 	}
-	if err = fg.Clear(); err != nil {
-		log.Printf("error clearing status: %s", err)
-	}
-
-	// --- Query both channels ---
+	if err = errqueue.Check(ctx, dev, errqueue.SCPI); err != nil {
+		log.Printf("33512B reported errors:\n%s", err)
+	}
+	printChannels(fg)
+
+	// --- Reconfigure both channels phase locked ---
+
+	// Channel 1 outputs a 100 Hz sine wave and channel 2 a 500 Hz square wave.
+	// Channel 2's frequency is coupled to five times channel 1's and its phase
+	// is offset from channel 1's, with both channels started together. This
+	// replaces the burst configuration above.
+	cfg := phaseLockedConfig{
+		frequency: 100.0,
+		ratio:     5.0,
+		phase:     phase,
+		ch1: channelConfig{
+			waveform:  fgen.Sine,
+			amplitude: 0.5,
+			offset:    0.0,
+		},
+		ch2: channelConfig{
+			waveform:  fgen.Square,
+			amplitude: 2.0,
+			offset:    0.5,
+		},
+	}
+	if err = configurePhaseLocked(ctx, dev, fg, cfg); err != nil {
+		log.Fatalf("error configuring phase-locked channels:\n%s", err)
+	}
+	printChannels(fg)
+}
 
+// printChannels logs the waveform settings of both channels.
+func printChannels(fg *kt33000.Driver) {
 	for i := range 2 {
 		ch, err := fg.Channel(i)
 		if err != nil {
@@ -192,5 +237,4 @@ func main() {
 		log.Printf("CH%d: %s, %.0f Hz, %.3f Vpp, %.3f Vdc offset, enabled=%t",
 			i+1, wave, freq, amp, offset, enabled)
 	}
-
 }
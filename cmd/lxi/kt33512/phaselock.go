@@ -0,0 +1,200 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/fgen/keysight/kt33000"
+)
+
+// channelConfig describes the standard waveform output by one channel.
+type channelConfig struct {
+	waveform  fgen.StandardWaveform
+	amplitude float64 // Vpp
+	offset    float64 // Vdc
+}
+
+// fgenChannel is the subset of the kt33000 channel methods used to configure
+// and verify the phase-locked channels.
+type fgenChannel interface {
+	DisableOutput() error
+	EnableOutput() error
+	SetOperationMode(fgen.OperationMode) error
+	StandardWaveform() (fgen.StandardWaveform, error)
+	Frequency() (float64, error)
+	Amplitude() (float64, error)
+	DCOffset() (float64, error)
+}
+
+// phaseLockedConfig describes a dual-channel configuration in which channel
+// 2's frequency is coupled to channel 1's by a fixed ratio and channel 2 runs
+// at a fixed phase offset relative to channel 1.
+type phaseLockedConfig struct {
+	frequency float64 // channel 1 frequency in Hz
+	ratio     float64 // channel 2 frequency divided by channel 1 frequency
+	phase     float64 // channel 2 phase offset relative to channel 1 in degrees
+	ch1, ch2  channelConfig
+}
+
+// configurePhaseLocked configures both channels of a 33500B series generator
+// according to cfg, couples their frequencies, enables the outputs, and then
+// synchronizes the phase of both channels so they start together. The
+// programmed settings are read back and any mismatches are returned along
+// with the entries left in the instrument's error queue, such as settings
+// conflicts, instead of clearing them.
+func configurePhaseLocked(
+	ctx context.Context,
+	dev ivi.Transport,
+	fg *kt33000.Driver,
+	cfg phaseLockedConfig,
+) error {
+	if cfg.ratio <= 0 {
+		return fmt.Errorf("invalid frequency coupling ratio %g", cfg.ratio)
+	}
+	ch1, err := fg.Channel(0)
+	if err != nil {
+		return fmt.Errorf("error getting channel 0: %w", err)
+	}
+	ch2, err := fg.Channel(1)
+	if err != nil {
+		return fmt.Errorf("error getting channel 1: %w", err)
+	}
+
+	// Turn off coupling while the channels are configured independently so the
+	// instrument doesn't apply channel 1 changes to channel 2.
+	if err = dev.Command(ctx, "SOUR1:FREQ:COUP OFF"); err != nil {
+		return fmt.Errorf("error disabling frequency coupling: %w", err)
+	}
+	for i, ch := range []fgenChannel{ch1, ch2} {
+		if err = ch.DisableOutput(); err != nil {
+			return fmt.Errorf("error disabling output on ch%d: %w", i+1, err)
+		}
+		if err = ch.SetOperationMode(fgen.ContinuousMode); err != nil {
+			return fmt.Errorf("error setting continuous mode on ch%d: %w", i+1, err)
+		}
+	}
+
+	// The last argument to ConfigureStandardWaveform is the start phase, which
+	// sets channel 2's offset relative to channel 1.
+	if err = ch1.ConfigureStandardWaveform(
+		cfg.ch1.waveform, cfg.ch1.amplitude, cfg.ch1.offset, cfg.frequency, 0.0,
+	); err != nil {
+		return fmt.Errorf("error configuring ch1 waveform: %w", err)
+	}
+	if err = ch2.ConfigureStandardWaveform(
+		cfg.ch2.waveform, cfg.ch2.amplitude, cfg.ch2.offset, cfg.frequency*cfg.ratio, cfg.phase,
+	); err != nil {
+		return fmt.Errorf("error configuring ch2 waveform: %w", err)
+	}
+
+	// The IVI fgen class has no frequency coupling, so use the 33500B SCPI
+	// commands to keep channel 2 at a fixed ratio of channel 1.
+	for _, cmd := range []string{
+		"SOUR1:FREQ:COUP:MODE RAT",
+		fmt.Sprintf("SOUR1:FREQ:COUP:RAT %g", cfg.ratio),
+		"SOUR1:FREQ:COUP ON",
+	} {
+		if err = dev.Command(ctx, cmd); err != nil {
+			return fmt.Errorf("error sending %q: %w", cmd, err)
+		}
+	}
+
+	// Enable both outputs and then restart both channels at the same time so
+	// the programmed phase offset is relative to a common start.
+	for i, ch := range []fgenChannel{ch1, ch2} {
+		if err = ch.EnableOutput(); err != nil {
+			return fmt.Errorf("error enabling output on ch%d: %w", i+1, err)
+		}
+	}
+	if err = dev.Command(ctx, "SOUR1:PHAS:SYNC"); err != nil {
+		return fmt.Errorf("error synchronizing phase: %w", err)
+	}
+	if _, err = dev.Query(ctx, "*OPC?"); err != nil {
+		return fmt.Errorf("error waiting for operation complete: %w", err)
+	}
+
+	// Verify the settings actually in effect and report anything the
+	// instrument adjusted or rejected.
+	var errs []error
+	errs = append(errs, verifyChannel(1, ch1, cfg.ch1, cfg.frequency)...)
+	errs = append(errs, verifyChannel(2, ch2, cfg.ch2, cfg.frequency*cfg.ratio)...)
+	errs = append(errs, verifyCoupling(ctx, dev, cfg)...)
+	errs = append(errs, errqueue.Check(ctx, dev, errqueue.SCPI))
+	return errors.Join(errs...)
+}
+
+// verifyChannel reads back the waveform settings of the 1-based channel and
+// returns an error for each setting differing from the expected value.
+func verifyChannel(n int, ch fgenChannel, want channelConfig, freq float64) []error {
+	var errs []error
+	wave, err := ch.StandardWaveform()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("ch%d: error querying waveform: %w", n, err))
+	} else if wave != want.waveform {
+		errs = append(errs, fmt.Errorf("ch%d: waveform = %s, want %s", n, wave, want.waveform))
+	}
+	checks := []struct {
+		name  string
+		query func() (float64, error)
+		want  float64
+		tol   float64
+	}{
+		{"frequency", ch.Frequency, freq, 1e-6 * freq},
+		{"amplitude", ch.Amplitude, want.amplitude, 1e-3},
+		{"DC offset", ch.DCOffset, want.offset, 1e-3},
+	}
+	for _, c := range checks {
+		got, err := c.query()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ch%d: error querying %s: %w", n, c.name, err))
+			continue
+		}
+		if math.Abs(got-c.want) > c.tol {
+			errs = append(errs, fmt.Errorf("ch%d: %s = %g, want %g", n, c.name, got, c.want))
+		}
+	}
+	return errs
+}
+
+// verifyCoupling reads back the frequency coupling state and ratio along with
+// channel 2's phase offset.
+func verifyCoupling(ctx context.Context, dev ivi.Transport, cfg phaseLockedConfig) []error {
+	var errs []error
+	checks := []struct {
+		query string
+		want  float64
+		tol   float64
+	}{
+		{"SOUR1:FREQ:COUP?", 1, 0},
+		{"SOUR1:FREQ:COUP:RAT?", cfg.ratio, 1e-9 * cfg.ratio},
+		{"SOUR2:PHAS?", cfg.phase, 1e-3},
+	}
+	for _, c := range checks {
+		resp, err := dev.Query(ctx, c.query)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error querying %s: %w", c.query, err))
+			continue
+		}
+		got, err := strconv.ParseFloat(strings.TrimSpace(resp), 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error parsing %s response %q: %w", c.query, resp, err))
+			continue
+		}
+		if math.Abs(got-c.want) > c.tol {
+			errs = append(errs, fmt.Errorf("%s = %g, want %g", c.query, got, c.want))
+		}
+	}
+	return errs
+}
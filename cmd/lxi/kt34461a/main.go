@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	"github.com/gotmc/ivi-examples/internal/measure"
 	"github.com/gotmc/ivi/dmm"
 	"github.com/gotmc/ivi/dmm/keysight/kt34400"
 	"github.com/gotmc/lxi"
@@ -36,6 +38,7 @@ func main() {
 		5*time.Second,
 		"I/O timeout applied to each instrument operation",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	// Bound the initial TCP dial with the same timeout so an unreachable
@@ -44,10 +47,17 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	// Create a new LXI device
 	address := fmt.Sprintf("TCPIP0::%s::5025::SOCKET", ip)
 	log.Printf("VISA address = %s", address)
 	log.Printf("I/O timeout = %s", timeout)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Create a new LXI device
 	dev, err := lxi.NewDevice(ctx, address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
@@ -130,7 +140,7 @@ func main() {
 	if err != nil {
 		log.Printf("error querying the range: %s", err)
 	}
-	log.Printf("Range = %.f V / %s", rng, autoRange)
+	log.Printf("Range = %s / %s", measure.DMM(fcn, rng), autoRange)
 
 	// Set the manual range.
 	err = d.SetRange(dmm.AutoOff, 10.0)
@@ -143,7 +153,7 @@ func main() {
 	if err != nil {
 		log.Printf("error querying the range: %s", err)
 	}
-	log.Printf("Range = %.f V / %s", rng, autoRange)
+	log.Printf("Range = %s / %s", measure.DMM(fcn, rng), autoRange)
 
 	// Set the auto range.
 	err = d.SetRange(dmm.AutoOn, 0.0)
@@ -156,7 +166,7 @@ func main() {
 	if err != nil {
 		log.Printf("error querying the range: %s", err)
 	}
-	log.Printf("Range = %.f V / %s", rng, autoRange)
+	log.Printf("Range = %s / %s", measure.DMM(fcn, rng), autoRange)
 
 	// Set the measurement function to resistance and then query.
 	newFcn = dmm.TwoWireResistance
@@ -182,7 +192,7 @@ func main() {
 	if err != nil {
 		log.Printf("error querying the range: %s", err)
 	}
-	log.Printf("Range = %g ohms / %s", rng, autoRange)
+	log.Printf("Range = %s / %s", measure.DMM(fcn, rng), autoRange)
 
 	// Set the measurement function to DC volts and then query.
 	newFcn = dmm.DCVolts
@@ -207,28 +217,28 @@ func main() {
 	if err != nil {
 		log.Printf("error querying the range: %s", err)
 	}
-	log.Printf("Range = %g ohms / %s", rng, autoRange)
+	log.Printf("Range = %s / %s", measure.DMM(fcn, rng), autoRange)
 
 	// Read the measurement.
 	msr, err := d.ReadMeasurement(100 * time.Millisecond)
 	if err != nil {
 		log.Printf("error reading the measurement: %s", err)
 	}
-	log.Printf("Measurement reading #1 = %g V", msr)
+	log.Printf("Measurement reading #1 = %s", measure.DMM(fcn, msr))
 
 	// Read the measurement.
 	msr, err = d.ReadMeasurement(100 * time.Millisecond)
 	if err != nil {
 		log.Printf("error reading the measurement: %s", err)
 	}
-	log.Printf("Measurement reading #2 = %g V", msr)
+	log.Printf("Measurement reading #2 = %s", measure.DMM(fcn, msr))
 
 	// Read the measurement.
 	msr, err = d.ReadMeasurement(100 * time.Millisecond)
 	if err != nil {
 		log.Printf("error reading the measurement: %s", err)
 	}
-	log.Printf("Measurement reading #3 = %g V", msr)
+	log.Printf("Measurement reading #3 = %s", measure.DMM(fcn, msr))
 
 	// Set the measurement function to frequency and then query.
 	newFcn = dmm.Frequency
@@ -248,7 +258,7 @@ func main() {
 	if err != nil {
 		log.Printf("error reading the measurement: %s", err)
 	}
-	log.Printf("Frequency = %g Hz", msr)
+	log.Printf("Frequency = %s", measure.DMM(fcn, msr))
 
 	// Set the measurement function to period and then query.
 	newFcn = dmm.Period
@@ -268,7 +278,7 @@ func main() {
 	if err != nil {
 		log.Printf("error reading the measurement: %s", err)
 	}
-	log.Printf("Period = %g s", msr)
+	log.Printf("Period = %s", measure.DMM(fcn, msr))
 
 	// Query the terminals selected.
 	term, err := d.SelectedTerminals()
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/dcpwr"
 	"github.com/gotmc/ivi/dcpwr/kikusui/pmx"
 	"github.com/gotmc/lxi"
@@ -28,12 +29,20 @@ func main() {
 		"192.168.1.100",
 		"IP address of Kikusui PMX DC power supply",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	ctx := context.Background()
 
-	// Create a new LXI device
 	address := fmt.Sprintf("TCPIP0::%s::5025::SOCKET", ip)
+
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Create a new LXI device
 	dev, err := lxi.NewDevice(ctx, address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
@@ -0,0 +1,166 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/gpibbus"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/dmm/fluke/fluke45"
+)
+
+func main() {
+	log.Println("IVI Prologix VCP GPIB Shared Bus E3631A and Fluke 45 Example Application")
+
+	var (
+		serialPort string
+		psuAddr    int
+		dmmAddr    int
+		steps      int
+	)
+	flag.StringVar(
+		&serialPort,
+		"port",
+		"/dev/tty.usbserial-PX8X3YR6",
+		"Serial port for Prologix VCP GPIB controller",
+	)
+	flag.IntVar(&psuAddr, "psu-gpib", 5, "GPIB address of the E3631A power supply")
+	flag.IntVar(&dmmAddr, "dmm-gpib", 10, "GPIB address of the Fluke 45 multimeter")
+	flag.IntVar(&steps, "steps", 5, "Number of 1 V steps of the 6V channel")
+	lockTimeout := instlock.TimeoutFlag()
+	flag.Parse()
+
+	ctx := context.Background()
+
+	release, err := instlock.Hold(serialPort, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
+	// Open the Prologix controller once and hand out a device for each GPIB
+	// address. The bus readdresses the controller before each transaction.
+	log.Printf("Serial port = %s", serialPort)
+	bus, err := gpibbus.Open(serialPort)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		if err := bus.Close(); err != nil {
+			log.Printf("error closing serial port: %s", err)
+		}
+	}()
+	prologixVer, err := bus.Version(ctx)
+	if err != nil {
+		log.Fatalf("Unable to determine Prologix controller version: %s", err)
+	}
+	log.Printf("Using %s", prologixVer)
+
+	psuDev, err := bus.Device(ctx, psuAddr, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dmmDev, err := bus.Device(ctx, dmmAddr, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create the IVI drivers concurrently. Their resets and queries share the
+	// bus without interleaving.
+	var (
+		ps     *e36000.Driver
+		meter  *fluke45.Driver
+		psuErr error
+		dmmErr error
+		wg     sync.WaitGroup
+	)
+	wg.Go(func() { ps, psuErr = e36000.New(psuDev, ivi.WithReset()) })
+	wg.Go(func() { meter, dmmErr = fluke45.New(dmmDev, ivi.WithReset()) })
+	wg.Wait()
+	if psuErr != nil {
+		log.Fatalf("IVI e36000 instrument error: %s", psuErr)
+	}
+	if dmmErr != nil {
+		log.Fatalf("IVI fluke45 instrument error: %s", dmmErr)
+	}
+	log.Print("Created new IVI e36000 and fluke45 instruments")
+
+	ch6v, err := ps.Channel(0)
+	if err != nil {
+		log.Fatalf("error getting channel 0: %s", err)
+	}
+	if err := meter.SetMeasurementFunction(dmm.DCVolts); err != nil {
+		log.Fatalf("error setting measurement function: %s", err)
+	}
+
+	// Step the power supply's 6V channel in one goroutine while reading the
+	// multimeter in another.
+	done := make(chan struct{})
+	wg.Go(func() {
+		defer close(done)
+		if err := ch6v.SetCurrentLimit(0.1); err != nil {
+			log.Print(err)
+		}
+		if err := ch6v.EnableOutput(); err != nil {
+			log.Print(err)
+		}
+		for i := 1; i <= steps; i++ {
+			if err := ch6v.SetVoltageLevel(float64(i)); err != nil {
+				log.Printf("error setting voltage level: %s", err)
+				return
+			}
+			v, err := ch6v.MeasureVoltage()
+			if err != nil {
+				log.Printf("error measuring voltage: %s", err)
+				return
+			}
+			log.Printf("E3631A 6V channel = %.3f Vdc", v)
+			time.Sleep(time.Second)
+		}
+	})
+	wg.Go(func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			v, err := meter.ReadMeasurement(5 * time.Second)
+			if err != nil {
+				log.Printf("error reading Fluke 45: %s", err)
+				return
+			}
+			log.Printf("Fluke 45 = %.4f Vdc", v)
+		}
+	})
+	wg.Wait()
+
+	if err := ch6v.DisableOutput(); err != nil {
+		log.Print(err)
+	}
+
+	// Close the IVI drivers and return both instruments to local control.
+	if err := ps.Close(); err != nil {
+		log.Printf("error closing IVI e36000 driver: %s", err)
+	}
+	if err := meter.Close(); err != nil {
+		log.Printf("error closing IVI fluke45 driver: %s", err)
+	}
+	for _, d := range []*gpibbus.Device{psuDev, dmmDev} {
+		if err := d.FrontPanel(ctx, true); err != nil {
+			log.Printf("error setting local control for GPIB %d: %s", d.Addr(), err)
+		}
+		d.Close()
+	}
+}
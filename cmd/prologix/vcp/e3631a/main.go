@@ -12,6 +12,7 @@ import (
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
 	"github.com/gotmc/prologix"
 	"github.com/gotmc/prologix/driver/vcp"
@@ -34,10 +35,17 @@ func init() {
 func main() {
 	log.Println("IVI Prologix VCP GPIB Keysight E3631A Example Application")
 	// Parse the flags
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	ctx := context.Background()
 
+	release, err := instlock.Hold(serialPort, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
 	log.Printf("Serial port = %s", serialPort)
 	vcp, err := vcp.NewVCP(serialPort)
 	if err != nil {
@@ -6,10 +6,14 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	"github.com/gotmc/ivi/dmm"
 	"github.com/gotmc/ivi/dmm/fluke/fluke45"
 	"github.com/gotmc/prologix"
 	"github.com/gotmc/prologix/driver/vcp"
@@ -23,8 +27,15 @@ func main() {
 		"/dev/tty.usbserial-PX8X3YR6",
 		"Serial port for Prologix VCP GPIB controller",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
+	release, err := instlock.Hold(serialPort, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
 	vcp, err := vcp.NewVCP(serialPort)
 	if err != nil {
 		log.Fatal(err)
@@ -43,19 +54,35 @@ func main() {
 	log.Printf("Using %s", prologixVer)
 
 	// Create a new IVI instance of the Fluke multimeter
-	dmm, err := fluke45.New(gpib, ivi.WithReset())
+	meter, err := fluke45.New(gpib, ivi.WithReset())
 	if err != nil {
 		log.Fatalf("IVI instrument error: %s", err)
 	}
 
-	fcn, err := dmm.MeasurementFunction()
+	// The Fluke 45 has no SCPI error queue, so read its standard event status
+	// register after each configuration step to report any commands it
+	// rejected.
+	ctx := context.Background()
+	chk := errqueue.NewChecker(gpib, errqueue.Fluke45)
+	if err = chk.Step(ctx, "set measurement function", func() error {
+		return meter.SetMeasurementFunction(dmm.DCVolts)
+	}); err != nil {
+		log.Fatalf("error setting measurement function: %s", err)
+	}
+	if err = chk.Step(ctx, "set range", func() error {
+		return meter.SetRange(dmm.AutoOn, 0)
+	}); err != nil {
+		log.Fatalf("error setting range: %s", err)
+	}
+
+	fcn, err := meter.MeasurementFunction()
 	if err != nil {
 		log.Fatalf("error getting measurement function: %s", err)
 	}
 	log.Printf("MeasurementFunction = %s", fcn)
 
 	// Close the IVI driver to return the instrument to local control.
-	if err := dmm.Close(); err != nil {
+	if err := meter.Close(); err != nil {
 		log.Printf("error closing IVI driver: %s", err)
 	}
 
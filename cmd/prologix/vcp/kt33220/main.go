@@ -10,6 +10,7 @@ import (
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/keysight/kt33000"
 	"github.com/gotmc/prologix"
@@ -33,8 +34,15 @@ func init() {
 func main() {
 	log.Println("IVI Prologix VCP GPIB Keysight 33220A Example Application")
 	// Parse the flags
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
+	release, err := instlock.Hold(serialPort, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
 	log.Printf("Serial port = %s", serialPort)
 	vcp, err := vcp.NewVCP(serialPort)
 	if err != nil {
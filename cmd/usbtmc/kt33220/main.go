@@ -11,6 +11,7 @@ import (
 	"log"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/keysight/kt33000"
 	"github.com/gotmc/usbtmc"
@@ -39,6 +40,7 @@ func main() {
 		"MY44035349",
 		"Serial number of Keysight 33220A",
 	)
+	lockTimeout := instlock.TimeoutFlag()
 	flag.Parse()
 
 	// Create new VISA resource
@@ -49,6 +51,12 @@ func main() {
 	// Parse the flags
 	flag.Parse()
 
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
 	// Create a USBTMC context and set the debug level
 	usbCtx, err := usbtmc.NewContext()
 	if err != nil {
@@ -7,19 +7,73 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/swtch/keysight/u2751a"
 	"github.com/gotmc/usbtmc"
 	_ "github.com/gotmc/usbtmc/driver/google"
 )
 
+// USB vendor and product IDs of the Keysight U2751A.
+const (
+	vendorID  = 0x0957
+	productID = 0x3D18
+)
+
 func main() {
+	var (
+		historyFile  string
+		lifetime     int64
+		warn         float64
+		serialNumber string
+	)
+
+	// Get the serial number of the U2751A from CLI flag.
+	flag.StringVar(&serialNumber, "sn", "", "Serial number of Keysight U2751A")
+
+	// Get the relay history file and wear thresholds from CLI flags.
+	flag.StringVar(
+		&historyFile,
+		"history",
+		"u2751a-relays.json",
+		"JSON file used to record relay cycle count history",
+	)
+	flag.Int64Var(
+		&lifetime,
+		"lifetime",
+		100000,
+		"Rated relay lifetime in cycles",
+	)
+	flag.Float64Var(
+		&warn,
+		"warn",
+		0.8,
+		"Fraction of the rated lifetime at which a relay is flagged",
+	)
+	lockTimeout := instlock.TimeoutFlag()
+	flag.Parse()
+	if serialNumber == "" {
+		log.Fatal("the serial number of the U2751A is required (-sn)")
+	}
+	if lifetime <= 0 {
+		log.Fatalf("relay lifetime must be positive, got %d", lifetime)
+	}
 
 	ctx := context.Background()
 
+	address := fmt.Sprintf("USB0::0x%04X::0x%04X::%s::INSTR", vendorID, productID, serialNumber)
+	release, err := instlock.Hold(address, *lockTimeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer release()
+
 	// Create a USBTMC context and set the debug level
 	usbCtx, err := usbtmc.NewContext()
 	if err != nil {
@@ -33,7 +87,8 @@ func main() {
 	usbCtx.SetDebugLevel(1)
 
 	// Create a new USBTMC device
-	dev, err := usbCtx.NewDeviceByVIDPID(0x0957, 0x3D18)
+	log.Printf("VISA address = %s", address)
+	dev, err := usbCtx.NewDevice(address)
 	if err != nil {
 		log.Fatalf("NewDevice error: %s", err)
 	}
@@ -64,6 +119,14 @@ func main() {
 	}
 	log.Printf("Instrument model = %s", model)
 
+	// The serial number keys the relay history, so the counts of each matrix
+	// module are tracked separately.
+	sn, err := sw.InstrumentSerialNumber()
+	if err != nil {
+		log.Fatalf("error querying instrument sn: %s", err)
+	}
+	log.Printf("Instrument S/N = %s", sn)
+
 	// Get a channel by ID and determine the wiremode.
 	idx := 0
 	ch, err := sw.ChannelByID(idx)
@@ -117,15 +180,53 @@ func main() {
 		log.Printf("error trying to connect Col1 and Col2: %s", err)
 	}
 
-	// Determine the relay cycle counts
+	// Determine the relay cycle counts for each row of the matrix.
+	snapshot := relaySnapshot{
+		Time:   time.Now().UTC(),
+		Counts: make(map[int]int64),
+	}
 	rows := []string{"101:108", "201:208", "301:308", "401:408"}
 	for i, row := range rows {
+		channels, err := parseChannelRange(row)
+		if err != nil {
+			log.Fatal(err)
+		}
 		q := fmt.Sprintf("diag:rel:cycl? (@%s)", row)
 		resp, err := dev.Query(ctx, q)
 		if err != nil {
 			log.Printf("error querying relay cycle counts on row %d: %s", i+1, err)
+			continue
+		}
+		counts, err := parseCycleCounts(resp, channels)
+		if err != nil {
+			log.Printf("error parsing relay cycle counts on row %d: %s", i+1, err)
+			continue
+		}
+		for ch, n := range counts {
+			snapshot.Counts[ch] = n
 		}
-		log.Printf("Row %d cycle counts = %s", i+1, resp)
+	}
+	if len(snapshot.Counts) == 0 {
+		log.Fatal("no relay cycle counts were read")
 	}
 
+	// Append the counts to the history for this matrix module and report the
+	// relays approaching the end of their rated life.
+	history, err := loadRelayHistory(historyFile)
+	if err != nil {
+		log.Fatalf("error loading relay history: %s", err)
+	}
+	history[sn] = append(history[sn], snapshot)
+	if err = history.save(historyFile); err != nil {
+		log.Fatalf("error saving relay history: %s", err)
+	}
+	log.Printf("Recorded snapshot %d for S/N %s in %s", len(history[sn]), sn, historyFile)
+
+	flagged := reportRelayWear(os.Stdout, history[sn], lifetime, warn)
+	if flagged > 0 {
+		log.Printf(
+			"%d relay(s) at or above %.0f%% of the rated %d cycle lifetime",
+			flagged, 100*warn, lifetime,
+		)
+	}
 }
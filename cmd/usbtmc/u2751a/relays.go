@@ -0,0 +1,162 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relaySnapshot records the relay cycle counts read from a U2751A at one point
+// in time. Counts are keyed by the relay's channel number (e.g., 101 for row
+// 1, column 1).
+type relaySnapshot struct {
+	Time   time.Time     `json:"time"`
+	Counts map[int]int64 `json:"counts"`
+}
+
+// relayHistory holds the relay cycle count snapshots for each switch matrix
+// keyed by the instrument's serial number, so one history file can track
+// several matrix modules.
+type relayHistory map[string][]relaySnapshot
+
+// parseChannelRange expands a SCPI channel range such as "101:108" into the
+// individual channel numbers.
+func parseChannelRange(r string) ([]int, error) {
+	first, last, found := strings.Cut(r, ":")
+	start, err := strconv.Atoi(strings.TrimSpace(first))
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel range %q: %w", r, err)
+	}
+	if !found {
+		return []int{start}, nil
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(last))
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel range %q: %w", r, err)
+	}
+	if end < start {
+		return nil, fmt.Errorf("invalid channel range %q: end before start", r)
+	}
+	channels := make([]int, 0, end-start+1)
+	for ch := start; ch <= end; ch++ {
+		channels = append(channels, ch)
+	}
+	return channels, nil
+}
+
+// parseCycleCounts parses the comma separated response to a DIAG:REL:CYCL?
+// query into a cycle count for each of the queried channels.
+func parseCycleCounts(resp string, channels []int) (map[int]int64, error) {
+	fields := strings.Split(strings.TrimSpace(resp), ",")
+	if len(fields) != len(channels) {
+		return nil, fmt.Errorf(
+			"got %d cycle counts for %d channels in %q",
+			len(fields), len(channels), resp,
+		)
+	}
+	counts := make(map[int]int64, len(channels))
+	for i, field := range fields {
+		n, err := strconv.ParseInt(strings.TrimSpace(field), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cycle count for channel %d: %w", channels[i], err)
+		}
+		counts[channels[i]] = n
+	}
+	return counts, nil
+}
+
+// loadRelayHistory reads the relay history file. A missing file isn't an error
+// and yields an empty history.
+func loadRelayHistory(path string) (relayHistory, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is supplied by the user
+	if errors.Is(err, fs.ErrNotExist) {
+		return relayHistory{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	history := relayHistory{}
+	if err = json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("error parsing relay history %s: %w", path, err)
+	}
+	return history, nil
+}
+
+// save writes the relay history to a temporary file and then renames it into
+// place so an interrupted run can't truncate the existing history.
+func (h relayHistory) save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// reportRelayWear writes the latest cycle count of each relay as a fraction of
+// the rated lifetime and flags the relays at or beyond the warn fraction. When
+// there are at least two snapshots, the cycle rate between the first and last
+// snapshot is used to estimate the days remaining until the rated lifetime.
+// The number of flagged relays is returned.
+func reportRelayWear(w io.Writer, snapshots []relaySnapshot, lifetime int64, warn float64) int {
+	if len(snapshots) == 0 {
+		return 0
+	}
+	first, latest := snapshots[0], snapshots[len(snapshots)-1]
+	days := latest.Time.Sub(first.Time).Hours() / 24
+
+	channels := make([]int, 0, len(latest.Counts))
+	for ch := range latest.Counts {
+		channels = append(channels, ch)
+	}
+	slices.Sort(channels)
+
+	flagged := 0
+	_, _ = fmt.Fprintf(w, "%-7s  %12s  %7s  %12s  %s\n",
+		"Relay", "Cycles", "Life", "Days left", "Status")
+	for _, ch := range channels {
+		count := latest.Counts[ch]
+		used := float64(count) / float64(lifetime)
+
+		remaining := "-"
+		if prev, ok := first.Counts[ch]; ok && days > 0 && count > prev {
+			perDay := float64(count-prev) / days
+			left := max(lifetime-count, 0)
+			remaining = fmt.Sprintf("%.0f", float64(left)/perDay)
+		}
+
+		status := "ok"
+		if used >= warn {
+			status = "REPLACE SOON"
+			flagged++
+		}
+		_, _ = fmt.Fprintf(w, "%-7d  %12d  %6.1f%%  %12s  %s\n",
+			ch, count, 100*used, remaining, status)
+	}
+	return flagged
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	"github.com/gotmc/ivi-examples/internal/lineproto"
+	"github.com/gotmc/ivi-examples/internal/reconnect"
+	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/dmm/keysight/kt34400"
+	_ "github.com/gotmc/usbtmc/driver/google"
+	_ "github.com/gotmc/visa/driver/asrl"
+	_ "github.com/gotmc/visa/driver/tcpip"
+	_ "github.com/gotmc/visa/driver/usbtmc"
+)
+
+// probe reads one quantity from an instrument.
+type probe struct {
+	source   source
+	quantity string
+	unit     string
+	read     func() (float64, error)
+}
+
+// psuChannel is the subset of the e36000 channel methods used for logging.
+type psuChannel interface {
+	MeasureVoltage() (float64, error)
+	MeasureCurrent() (float64, error)
+}
+
+func main() {
+	log.Println("IVI VISA Resilient Data Logger Application")
+
+	var (
+		dmmAddress string
+		psuAddress string
+		psuCh      int
+		interval   time.Duration
+		count      int
+		output     string
+		format     string
+		influxURL  string
+		token      string
+		batch      int
+		timeout    time.Duration
+		maxBackoff time.Duration
+		replay     bool
+	)
+	flag.StringVar(
+		&dmmAddress,
+		"dmm",
+		"",
+		"VISA address of Keysight 34400 series DMM (empty = don't log)",
+	)
+	flag.StringVar(
+		&psuAddress,
+		"psu",
+		"",
+		"VISA address of Keysight E36000 series power supply (empty = don't log)",
+	)
+	flag.IntVar(&psuCh, "psu-ch", 1, "1-based power supply output to log")
+	flag.DurationVar(&interval, "interval", time.Second, "Time between readings")
+	flag.IntVar(&count, "count", 0, "Number of readings to log (0 = until interrupted)")
+	flag.StringVar(&output, "o", "", "File to write (empty = stdout)")
+	flag.StringVar(&format, "format", "csv", "Format of the file: csv or line (InfluxDB line protocol)")
+	flag.StringVar(
+		&influxURL,
+		"influx",
+		"",
+		"InfluxDB write URL to also push line protocol to, e.g., http://localhost:8086/write?db=lab",
+	)
+	flag.StringVar(&token, "influx-token", os.Getenv("INFLUX_TOKEN"), "InfluxDB API token")
+	flag.IntVar(&batch, "batch", 1000, "Number of readings pushed to InfluxDB per request")
+	flag.DurationVar(
+		&timeout,
+		"timeout",
+		5*time.Second,
+		"I/O timeout applied to each instrument operation, including reconnects",
+	)
+	flag.DurationVar(
+		&maxBackoff,
+		"max-backoff",
+		30*time.Second,
+		"Longest delay between reconnect attempts",
+	)
+	flag.BoolVar(
+		&replay,
+		"replay",
+		true,
+		"Re-apply the instrument configuration after reconnecting",
+	)
+	flag.Parse()
+
+	if dmmAddress == "" && psuAddress == "" {
+		log.Fatal("at least one of -dmm or -psu is required")
+	}
+
+	// Stop logging cleanly on Ctrl-C so the readings are flushed.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var w io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			log.Fatalf("error creating %s: %s", output, err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Printf("error closing %s: %s", output, err)
+			}
+		}()
+		w = f
+	}
+	var out multiSink
+	switch format {
+	case "csv":
+		s, err := newCSVSink(w)
+		if err != nil {
+			log.Fatalf("error writing CSV header: %s", err)
+		}
+		out = append(out, s)
+	case "line":
+		out = append(out, newLineSink(w, "ivi"))
+	default:
+		log.Fatalf("unknown format %q (want csv or line)", format)
+	}
+	if influxURL != "" {
+		influx := &influxSink{
+			w: lineproto.NewHTTPWriter(
+				influxURL,
+				lineproto.WithToken(token),
+				lineproto.WithBatchSize(batch),
+			),
+			measurement: "ivi",
+		}
+		// Push the readings still batched when logging stops.
+		defer func() {
+			if err := influx.Close(); err != nil {
+				log.Printf("error pushing readings: %s", err)
+			}
+		}()
+		out = append(out, influx)
+	}
+
+	opts := []reconnect.Option{
+		reconnect.WithBackoff(250*time.Millisecond, maxBackoff),
+	}
+	if replay {
+		opts = append(opts, reconnect.WithReplay())
+	}
+
+	var probes []probe
+	if dmmAddress != "" {
+		dev, lock := openDevice(dmmAddress, timeout, opts)
+		defer closeDevice(dev, lock)
+
+		// The DMM only measures, so it's safe to reset it. With -replay the
+		// reset and configuration below are re-sent if the DMM is power
+		// cycled.
+		d, err := kt34400.New(dev, ivi.WithReset(), ivi.WithTimeout(timeout))
+		if err != nil {
+			log.Fatalf("IVI DMM error: %s", err)
+		}
+		if err = d.SetMeasurementFunction(dmm.DCVolts); err != nil {
+			log.Fatalf("error setting DMM to DC volts: %s", err)
+		}
+		if err = d.SetRange(dmm.AutoOn, 0.0); err != nil {
+			log.Fatalf("error enabling DMM auto range: %s", err)
+		}
+		src := identify(dev.Address(), d)
+		probes = append(probes, probe{
+			source:   src,
+			quantity: "voltage",
+			unit:     "V",
+			read:     func() (float64, error) { return d.ReadMeasurement(timeout) },
+		})
+	}
+
+	if psuAddress != "" {
+		dev, lock := openDevice(psuAddress, timeout, opts)
+		defer closeDevice(dev, lock)
+
+		// Don't reset the power supply, since that would turn off the output
+		// powering the device under test; only measure what it's doing.
+		ps, err := e36000.New(dev, ivi.WithTimeout(timeout))
+		if err != nil {
+			log.Fatalf("IVI power supply error: %s", err)
+		}
+		var ch psuChannel
+		ch, err = ps.Channel(psuCh - 1)
+		if err != nil {
+			log.Fatalf("error getting power supply channel %d: %s", psuCh, err)
+		}
+		src := identify(dev.Address(), ps)
+		probes = append(probes,
+			probe{source: src, quantity: "voltage", unit: "V", read: ch.MeasureVoltage},
+			probe{source: src, quantity: "current", unit: "A", read: ch.MeasureCurrent},
+		)
+	}
+
+	// Each driver call is bounded by -timeout, which also bounds how long a
+	// call waits for a reconnect. If an instrument stays unreachable, its
+	// readings are skipped and the reconnect retried on the next interval.
+	log.Printf("Logging %d quantities every %s", len(probes), interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for n := 0; count == 0 || n < count; n++ {
+		now := time.Now()
+		for _, p := range probes {
+			v, err := p.read()
+			if err != nil {
+				log.Printf("%s: error reading %s: %s", p.source.address, p.quantity, err)
+				continue
+			}
+			if err := out.Write(reading{
+				time:     now,
+				source:   p.source,
+				quantity: p.quantity,
+				value:    v,
+				unit:     p.unit,
+			}); err != nil {
+				log.Fatalf("error writing reading: %s", err)
+			}
+		}
+		if err := out.Flush(); err != nil {
+			log.Fatalf("error flushing readings: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("Interrupted, stopping")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// openDevice opens a reconnecting VISA device that logs its connection state,
+// first waiting up to the timeout for other processes on this computer using
+// the instrument. The instrument stays locked while logging, including while
+// the device reconnects.
+func openDevice(address string, timeout time.Duration, opts []reconnect.Option) (*reconnect.Device, *instlock.Lock) {
+	log.Printf("VISA address = %s", address)
+	opts = slices.Concat(opts, []reconnect.Option{
+		reconnect.WithOnDisconnect(func(err error) {
+			log.Printf("%s: connection lost: %s", address, err)
+		}),
+		reconnect.WithOnReconnect(func(attempts int) {
+			log.Printf("%s: reconnected after %d attempt(s)", address, attempts)
+		}),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	lock, err := instlock.Acquire(ctx, address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dev, err := reconnect.New(ctx, address, opts...)
+	if err != nil {
+		log.Fatalf("VISA resource %s: %s", address, err)
+	}
+	return dev, lock
+}
+
+func closeDevice(dev *reconnect.Device, lock *instlock.Lock) {
+	if err := dev.Close(); err != nil {
+		log.Printf("error closing %s: %s", dev.Address(), err)
+	}
+	if err := lock.Release(); err != nil {
+		log.Printf("error releasing the lock on %s: %s", dev.Address(), err)
+	}
+}
+
+// identifier is implemented by all of the IVI drivers.
+type identifier interface {
+	InstrumentModel() (string, error)
+	InstrumentSerialNumber() (string, error)
+}
+
+// identify queries the model and serial number used to tag the readings.
+func identify(address string, inst identifier) source {
+	src := source{address: address}
+	var err error
+	if src.model, err = inst.InstrumentModel(); err != nil {
+		log.Printf("%s: error querying instrument model: %s", address, err)
+	}
+	if src.serial, err = inst.InstrumentSerialNumber(); err != nil {
+		log.Printf("%s: error querying instrument sn: %s", address, err)
+	}
+	log.Printf("%s: %s S/N %s", address, src.model, src.serial)
+	return src
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/lineproto"
+)
+
+// source identifies the instrument a reading came from.
+type source struct {
+	address string
+	model   string
+	serial  string
+}
+
+// reading is a single logged measurement.
+type reading struct {
+	time     time.Time
+	source   source
+	quantity string // e.g., "voltage" or "current"
+	value    float64
+	unit     string
+}
+
+// sink records readings. Flush is called after each logging interval so
+// readings aren't lost if the logger is killed.
+type sink interface {
+	Write(r reading) error
+	Flush() error
+}
+
+// csvSink writes readings as CSV rows with a header line.
+type csvSink struct {
+	w *csv.Writer
+}
+
+var csvHeader = []string{"time", "address", "model", "serial", "quantity", "value", "unit"}
+
+func newCSVSink(w io.Writer) (*csvSink, error) {
+	s := csvSink{w: csv.NewWriter(w)}
+	if err := s.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *csvSink) Write(r reading) error {
+	return s.w.Write([]string{
+		r.time.Format(time.RFC3339Nano),
+		r.source.address,
+		r.source.model,
+		r.source.serial,
+		r.quantity,
+		strconv.FormatFloat(r.value, 'g', -1, 64),
+		r.unit,
+	})
+}
+
+func (s *csvSink) Flush() error {
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// point converts a reading to line protocol with the quantity as the field
+// key, e.g., "ivi,address=...,model=34461A,serial=...,unit=V voltage=1.23".
+func point(measurement string, r reading) lineproto.Point {
+	return lineproto.Point{
+		Measurement: measurement,
+		Tags: []lineproto.Tag{
+			{Key: "address", Value: r.source.address},
+			{Key: "model", Value: r.source.model},
+			{Key: "serial", Value: r.source.serial},
+			{Key: "unit", Value: r.unit},
+		},
+		Fields: []lineproto.Field{{Key: r.quantity, Value: r.value}},
+		Time:   r.time,
+	}
+}
+
+// lineSink writes readings as line protocol to a file.
+type lineSink struct {
+	enc         *lineproto.Encoder
+	measurement string
+}
+
+func newLineSink(w io.Writer, measurement string) *lineSink {
+	return &lineSink{enc: lineproto.NewEncoder(w), measurement: measurement}
+}
+
+func (s *lineSink) Write(r reading) error { return s.enc.Encode(point(s.measurement, r)) }
+
+func (s *lineSink) Flush() error { return s.enc.Flush() }
+
+// influxSink pushes readings in batches to an InfluxDB-compatible endpoint.
+// Errors are logged rather than returned so the logger keeps running while
+// the endpoint is unreachable; the readings are sent once it's back.
+type influxSink struct {
+	w           *lineproto.HTTPWriter
+	measurement string
+}
+
+func (s *influxSink) Write(r reading) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := s.w.Write(ctx, point(s.measurement, r)); err != nil {
+		log.Printf("error pushing readings (%d pending): %s", s.w.Pending(), err)
+	}
+	return nil
+}
+
+// Flush does nothing since the HTTPWriter sends a batch when it's full or
+// due. Close sends the rest.
+func (s *influxSink) Flush() error { return nil }
+
+func (s *influxSink) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return s.w.Flush(ctx)
+}
+
+// multiSink writes readings to several sinks.
+type multiSink []sink
+
+func (m multiSink) Write(r reading) error {
+	var errs []error
+	for _, s := range m {
+		errs = append(errs, s.Write(r))
+	}
+	return errors.Join(errs...)
+}
+
+func (m multiSink) Flush() error {
+	var errs []error
+	for _, s := range m {
+		errs = append(errs, s.Flush())
+	}
+	return errors.Join(errs...)
+}
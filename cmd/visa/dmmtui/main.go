@@ -0,0 +1,88 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+func main() {
+	log.Println("IVI DMM Dashboard Application")
+
+	var (
+		address  string
+		driver   string
+		timeout  time.Duration
+		maxTime  time.Duration
+		interval time.Duration
+	)
+	flag.StringVar(
+		&address,
+		"visa",
+		"TCPIP0::10.12.100.56::5025::SOCKET",
+		"VISA address of the DMM",
+	)
+	flag.StringVar(&driver, "driver", "kt34400", "IVI driver of the DMM, kt34400 or fluke45")
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "I/O timeout")
+	flag.DurationVar(&maxTime, "maxtime", 5*time.Second, "Maximum time to wait for each reading")
+	flag.DurationVar(&interval, "interval", 0, "Time between readings (0 = read continuously)")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t, err := bench.OpenTransport(ctx, address, nil)
+	cancel()
+	if err != nil {
+		log.Fatalf("error opening %s: %s", address, err)
+	}
+	defer func() {
+		if err := t.Close(); err != nil {
+			log.Printf("error closing %s: %s", address, err)
+		}
+	}()
+
+	// The DMM isn't reset, so the dashboard starts with the function and
+	// range it was left in.
+	d, err := bench.NewDriver(driver, t, ivi.WithTimeout(timeout))
+	if err != nil {
+		log.Fatalf("IVI instrument error: %s", err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.Printf("error closing IVI driver: %s", err)
+		}
+	}()
+	inst, ok := d.(bench.DMM)
+	if !ok {
+		log.Printf("%s isn't a DMM driver", driver)
+		return
+	}
+	instModel, err := inst.InstrumentModel()
+	if err != nil {
+		log.Printf("error querying instrument model: %s", err)
+	}
+	sn, err := inst.InstrumentSerialNumber()
+	if err != nil {
+		log.Printf("error querying instrument sn: %s", err)
+	}
+
+	m := model{
+		m:        &meter{dmm: inst, maxTime: maxTime},
+		title:    fmt.Sprintf("%s S/N %s at %s", instModel, sn, address),
+		interval: interval,
+		reading:  true,
+	}
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		log.Printf("error running dashboard: %s", err)
+	}
+}
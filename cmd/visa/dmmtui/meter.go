@@ -0,0 +1,98 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dmm"
+)
+
+// functions are the measurement functions selectable from the keyboard,
+// in the order of their number keys.
+var functions = []dmm.MeasurementFunction{
+	dmm.DCVolts,
+	dmm.TwoWireResistance,
+	dmm.Frequency,
+	dmm.Period,
+}
+
+// setup is the measurement function and range of the DMM.
+type setup struct {
+	fcn       dmm.MeasurementFunction
+	autoRange dmm.AutoRange
+	rng       float64
+}
+
+// meter serializes access to the DMM, since Bubble Tea runs commands
+// concurrently.
+type meter struct {
+	mu      sync.Mutex
+	dmm     bench.DMM
+	maxTime time.Duration
+}
+
+func (m *meter) read() (float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.dmm.ReadMeasurement(m.maxTime)
+}
+
+func (m *meter) setup() (setup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.querySetup()
+}
+
+func (m *meter) querySetup() (setup, error) {
+	var (
+		s   setup
+		err error
+	)
+	if s.fcn, err = m.dmm.MeasurementFunction(); err != nil {
+		return s, fmt.Errorf("error querying the measurement function: %w", err)
+	}
+	if s.autoRange, s.rng, err = m.dmm.Range(); err != nil {
+		return s, fmt.Errorf("error querying the range: %w", err)
+	}
+	return s, nil
+}
+
+// setFunction selects the measurement function with auto range on.
+func (m *meter) setFunction(fcn dmm.MeasurementFunction) (setup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.dmm.SetMeasurementFunction(fcn); err != nil {
+		return setup{}, fmt.Errorf("error setting the measurement function: %w", err)
+	}
+	if err := m.dmm.SetRange(dmm.AutoOn, 0); err != nil {
+		return setup{}, fmt.Errorf("error setting the auto range: %w", err)
+	}
+	return m.querySetup()
+}
+
+// toggleAutoRange turns auto range off, holding the range it selected, or
+// back on.
+func (m *meter) toggleAutoRange() (setup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, err := m.querySetup()
+	if err != nil {
+		return s, err
+	}
+	if s.autoRange == dmm.AutoOff {
+		err = m.dmm.SetRange(dmm.AutoOn, 0)
+	} else {
+		err = m.dmm.SetRange(dmm.AutoOff, s.rng)
+	}
+	if err != nil {
+		return s, fmt.Errorf("error setting the range: %w", err)
+	}
+	return m.querySetup()
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi-examples/internal/tui"
+	"github.com/gotmc/ivi/dmm"
+)
+
+// maxHistory is the number of readings kept for the sparkline.
+const maxHistory = 500
+
+// readMsg carries a reading. gen is the setup generation the read was
+// started in, so readings taken before a change of function are dropped.
+type readMsg struct {
+	v   float64
+	err error
+	gen int
+}
+
+// setupMsg reports the DMM's setup after it was queried or changed.
+type setupMsg struct {
+	s       setup
+	err     error
+	changed bool
+}
+
+// model is the Bubble Tea model of the dashboard.
+type model struct {
+	m        *meter
+	title    string
+	interval time.Duration
+	setup    setup
+	gen      int
+	reading  bool // a read is in progress
+	paused   bool
+	last     float64
+	stats    stats
+	history  []float64
+	width    int
+	err      error
+}
+
+var help = tui.Help(
+	tui.Binding{Key: "1-4", Desc: "DC volts/2-wire Ω/frequency/period"},
+	tui.Binding{Key: "a", Desc: "toggle auto range"},
+	tui.Binding{Key: "r", Desc: "reset statistics"},
+	tui.Binding{Key: "space", Desc: "pause"},
+	tui.Binding{Key: "q", Desc: "quit"},
+)
+
+// Init queries the setup and starts reading; the model is created with
+// reading set.
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.querySetup(), m.read(0))
+}
+
+func (m model) querySetup() tea.Cmd {
+	return func() tea.Msg {
+		s, err := m.m.setup()
+		return setupMsg{s: s, err: err}
+	}
+}
+
+// read takes a reading after delay.
+func (m model) read(delay time.Duration) tea.Cmd {
+	gen := m.gen
+	read := func(time.Time) tea.Msg {
+		v, err := m.m.read()
+		return readMsg{v: v, err: err, gen: gen}
+	}
+	if delay <= 0 {
+		return func() tea.Msg { return read(time.Now()) }
+	}
+	return tea.Tick(delay, read)
+}
+
+func (m model) change(f func() (setup, error)) tea.Cmd {
+	return func() tea.Msg {
+		s, err := f()
+		return setupMsg{s: s, err: err, changed: true}
+	}
+}
+
+func (m *model) reset() {
+	m.stats = stats{}
+	m.history = m.history[:0]
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case readMsg:
+		m.reading = false
+		if msg.gen == m.gen {
+			m.err = msg.err
+			if msg.err == nil {
+				m.last = msg.v
+				m.stats.add(msg.v)
+				m.history = append(m.history, msg.v)
+				if len(m.history) > maxHistory {
+					m.history = m.history[len(m.history)-maxHistory:]
+				}
+			}
+		}
+		if m.paused {
+			return m, nil
+		}
+		m.reading = true
+		return m, m.read(m.interval)
+	case setupMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.setup = msg.s
+		}
+		if msg.changed {
+			// Drop the readings started before the change, which may be in
+			// the old function or range.
+			m.gen++
+			m.reset()
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case tea.KeyMsg:
+		return m.key(msg)
+	}
+	return m, nil
+}
+
+func (m model) key(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch k := msg.String(); k {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "1", "2", "3", "4":
+		fcn := functions[k[0]-'1']
+		return m, m.change(func() (setup, error) { return m.m.setFunction(fcn) })
+	case "a":
+		return m, m.change(m.m.toggleAutoRange)
+	case "r":
+		m.reset()
+	case " ":
+		m.paused = !m.paused
+		if !m.paused && !m.reading {
+			m.reading = true
+			return m, m.read(0)
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	unit := measure.DMMUnit(m.setup.fcn)
+	format := func(v float64) string { return measure.Format(v, unit, 7) }
+
+	var b strings.Builder
+	b.WriteString(tui.Title.Render(m.title) + "\n\n")
+	rng := "auto"
+	if m.setup.autoRange == dmm.AutoOff {
+		rng = "manual"
+	}
+	fmt.Fprintf(&b, "%s, %s range %s", m.setup.fcn, rng, format(m.setup.rng))
+	if m.paused {
+		b.WriteString("  " + tui.Warn.Render("paused"))
+	}
+	b.WriteString("\n\n")
+	if m.stats.n == 0 {
+		b.WriteString(tui.Faint.Render("waiting for a reading...") + "\n\n")
+	} else {
+		b.WriteString("  " + tui.Title.Render(format(m.last)) + "\n\n")
+		fmt.Fprintf(&b, "  %-6s %d\n", "n", m.stats.n)
+		fmt.Fprintf(&b, "  %-6s %s\n", "min", format(m.stats.min))
+		fmt.Fprintf(&b, "  %-6s %s\n", "max", format(m.stats.max))
+		fmt.Fprintf(&b, "  %-6s %s\n", "mean", format(m.stats.mean))
+		fmt.Fprintf(&b, "  %-6s %s\n\n", "σ", format(m.stats.stdDev()))
+		b.WriteString("  " + tui.Sparkline(m.history, max(m.width-4, 20)) + "\n\n")
+	}
+	if m.err != nil {
+		b.WriteString(tui.Error.Render(m.err.Error()))
+	}
+	b.WriteString("\n\n" + help + "\n")
+	return b.String()
+}
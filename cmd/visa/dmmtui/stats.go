@@ -0,0 +1,39 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import "math"
+
+// stats keeps running statistics of the readings using Welford's algorithm,
+// which doesn't lose precision when the readings are large compared to
+// their spread.
+type stats struct {
+	n    int
+	min  float64
+	max  float64
+	mean float64
+	m2   float64 // sum of squared differences from the mean
+}
+
+func (s *stats) add(v float64) {
+	s.n++
+	if s.n == 1 {
+		s.min, s.max = v, v
+	}
+	s.min = min(s.min, v)
+	s.max = max(s.max, v)
+	delta := v - s.mean
+	s.mean += delta / float64(s.n)
+	s.m2 += delta * (v - s.mean)
+}
+
+// stdDev returns the sample standard deviation.
+func (s *stats) stdDev() float64 {
+	if s.n < 2 {
+		return 0
+	}
+	return math.Sqrt(s.m2 / float64(s.n-1))
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/fgen/keysight/kt33000"
+)
+
+// arbName matches the names accepted for arbitrary waveforms by both the
+// 33200 and 33500 series: up to 12 characters starting with a letter.
+var arbName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,11}$`)
+
+func main() {
+	log.Println("IVI VISA Keysight 33220A/33512B Arbitrary Waveform Application")
+
+	var (
+		address   string
+		file      string
+		column    int
+		channel   int
+		points    int
+		name      string
+		frequency float64
+		amplitude float64
+		offset    float64
+		timeout   time.Duration
+	)
+	flag.StringVar(
+		&address,
+		"visa",
+		"TCPIP0::192.168.1.100::5025::SOCKET",
+		"VISA address of Keysight 33220A or 33512B",
+	)
+	flag.StringVar(&file, "file", "", "CSV or .npy file containing one period of samples")
+	flag.IntVar(&column, "column", -1, "0-based CSV column holding the samples (-1 = last)")
+	flag.IntVar(&channel, "ch", 1, "1-based output channel to play the waveform on")
+	flag.IntVar(&points, "points", 0, "Number of points to resample to (0 = keep if allowed)")
+	flag.StringVar(&name, "name", "GOARB", "Name of the arbitrary waveform in the generator")
+	flag.Float64Var(&frequency, "freq", 1000, "Waveform repetition frequency in Hz")
+	flag.Float64Var(&amplitude, "amp", 1.0, "Waveform amplitude in Vpp")
+	flag.Float64Var(&offset, "offset", 0.0, "Waveform DC offset in V")
+	flag.DurationVar(
+		&timeout,
+		"timeout",
+		30*time.Second,
+		"I/O timeout applied to each instrument operation",
+	)
+	flag.Parse()
+
+	if file == "" {
+		log.Fatal("the -file flag is required")
+	}
+	if !arbName.MatchString(name) {
+		log.Fatalf("invalid waveform name %q (letter followed by up to 11 letters, digits, or _)", name)
+	}
+
+	// Read the samples before touching the instrument so a bad file doesn't
+	// leave the generator half configured.
+	samples, err := loadSamples(file, column)
+	if err != nil {
+		log.Fatalf("error loading samples from %s: %s", file, err)
+	}
+	log.Printf("Read %d samples from %s", len(samples), file)
+	samples, err = normalize(samples)
+	if err != nil {
+		log.Fatalf("error normalizing samples: %s", err)
+	}
+
+	// Open the VISA resource, which can be either an LXI socket or a USBTMC
+	// address, through the bench package so that other processes using the
+	// instrument are waited for, up to the timeout.
+	log.Printf("VISA address = %s", address)
+	openCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	res, err := bench.OpenTransport(openCtx, address, nil)
+	cancel()
+	if err != nil {
+		log.Fatalf("VISA resource %s: %s", address, err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Printf("error closing VISA resource: %s", err)
+		}
+	}()
+
+	fg, err := kt33000.New(res, ivi.WithReset(), ivi.WithTimeout(timeout))
+	if err != nil {
+		log.Fatalf("IVI instrument error: %s", err)
+	}
+	defer func() {
+		if err := fg.Close(); err != nil {
+			log.Printf("error closing IVI driver: %s", err)
+		}
+	}()
+
+	// The arbitrary waveform memory differs between the 33200 and 33500
+	// series, so determine the limits from the instrument model.
+	model, err := fg.InstrumentModel()
+	if err != nil {
+		log.Fatalf("error querying instrument model: %s", err)
+	}
+	log.Printf("Instrument model = %s", model)
+	limits, err := limitsForModel(model)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if channel < 1 || channel > limits.channels {
+		log.Fatalf("channel %d out of range for %s (1-%d)", channel, model, limits.channels)
+	}
+
+	n := limits.targetPoints(len(samples), points)
+	if n != len(samples) {
+		log.Printf("Resampling %d samples to %d points", len(samples), n)
+	}
+	wfm := arbWaveform{
+		name:      name,
+		data:      resample(samples, n),
+		frequency: frequency,
+		amplitude: amplitude,
+		offset:    offset,
+	}
+
+	ch, err := fg.Channel(channel - 1)
+	if err != nil {
+		log.Fatalf("error getting channel %d: %s", channel, err)
+	}
+	if err = ch.DisableOutput(); err != nil {
+		log.Fatalf("error disabling output: %s", err)
+	}
+
+	// Prefer the IVI arbitrary waveform extension and fall back to SCPI DATA
+	// commands if the driver doesn't provide it.
+	ok, err := uploadWithIVI(fg, ch, wfm)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if ok {
+		log.Printf("Uploaded %d points using the IVI arbitrary waveform extension", n)
+	} else {
+		log.Printf("Driver lacks the IVI arbitrary waveform extension; using SCPI DATA")
+		if err = uploadWithSCPI(context.Background(), res, limits, channel, wfm, timeout); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Uploaded %d points as %s using SCPI", n, name)
+	}
+
+	if err = ch.EnableOutput(); err != nil {
+		log.Fatalf("error enabling output: %s", err)
+	}
+
+	// Query the resulting output settings.
+	amp, err := ch.Amplitude()
+	if err != nil {
+		log.Printf("error querying amplitude: %s", err)
+	}
+	log.Printf("Amplitude = %.3f Vpp", amp)
+
+	dc, err := ch.DCOffset()
+	if err != nil {
+		log.Printf("error querying DC offset: %s", err)
+	}
+	log.Printf("DC Offset = %.1f mV", 1000*dc)
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// loadSamples reads the waveform sample points from either a NumPy .npy file
+// or a CSV file depending on the file extension. For CSV files, column selects
+// the 0-based column to read with a negative column selecting the last one.
+func loadSamples(path string, column int) ([]float64, error) {
+	f, err := os.Open(path) // #nosec G304 -- path is supplied by the user
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	if strings.EqualFold(filepath.Ext(path), ".npy") {
+		return readNPY(f)
+	}
+	return readCSV(f, column)
+}
+
+// readCSV reads one column of floating point values from CSV data. Rows that
+// start with a '#' and rows whose selected column isn't a number, such as a
+// header row, are skipped.
+func readCSV(r io.Reader, column int) ([]float64, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var samples []float64
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		idx := column
+		if idx < 0 {
+			idx = len(record) - 1
+		}
+		if idx >= len(record) {
+			return nil, fmt.Errorf("CSV row has %d columns, need column %d", len(record), idx)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(record[idx]), 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, v)
+	}
+	if len(samples) == 0 {
+		return nil, errors.New("no numeric samples found in CSV data")
+	}
+	return samples, nil
+}
+
+var (
+	npyDescr = regexp.MustCompile(`'descr':\s*'([<>|=])([fiu])(\d+)'`)
+	npyShape = regexp.MustCompile(`'shape':\s*\(([^)]*)\)`)
+)
+
+// readNPY reads a one-dimensional array of numbers from NumPy .npy data. Two
+// dimensional arrays with a single row or column are flattened.
+func readNPY(r io.Reader) ([]float64, error) {
+	magic := make([]byte, 8)
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(magic[:6], []byte("\x93NUMPY")) {
+		return nil, errors.New("not a NumPy .npy file")
+	}
+
+	// Version 1.0 uses a 2-byte header length, while versions 2.0 and 3.0 use
+	// a 4-byte header length.
+	var headerLen int
+	switch magic[6] {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, err
+		}
+		headerLen = int(n)
+	case 2, 3:
+		var n uint32
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, err
+		}
+		headerLen = int(n)
+	default:
+		return nil, fmt.Errorf("unsupported .npy version %d.%d", magic[6], magic[7])
+	}
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	descr := npyDescr.FindSubmatch(header)
+	if descr == nil {
+		return nil, fmt.Errorf("unsupported .npy dtype in header %q", header)
+	}
+	// Fortran order doesn't matter for a single row or column, which is all
+	// that's accepted below, so the fortran_order key is ignored.
+	shape := npyShape.FindSubmatch(header)
+	if shape == nil {
+		return nil, fmt.Errorf("missing shape in .npy header %q", header)
+	}
+	count := 1
+	dims := 0
+	for dim := range strings.SplitSeq(string(shape[1]), ",") {
+		dim = strings.TrimSpace(dim)
+		if dim == "" {
+			continue
+		}
+		n, err := strconv.Atoi(dim)
+		if err != nil {
+			return nil, fmt.Errorf("invalid .npy shape %q", shape[1])
+		}
+		if n != 1 {
+			dims++
+		}
+		count *= n
+	}
+	if dims > 1 {
+		return nil, fmt.Errorf("expected a one-dimensional array, got shape (%s)", shape[1])
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if descr[1][0] == '>' {
+		order = binary.BigEndian
+	}
+	kind := descr[2][0]
+	size, _ := strconv.Atoi(string(descr[3]))
+
+	data := make([]byte, count*size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	samples := make([]float64, count)
+	for i := range samples {
+		b := data[i*size : (i+1)*size]
+		switch {
+		case kind == 'f' && size == 8:
+			samples[i] = math.Float64frombits(order.Uint64(b))
+		case kind == 'f' && size == 4:
+			samples[i] = float64(math.Float32frombits(order.Uint32(b)))
+		case kind == 'i' && size == 1:
+			samples[i] = float64(int8(b[0]))
+		case kind == 'i' && size == 2:
+			samples[i] = float64(int16(order.Uint16(b))) // #nosec G115
+		case kind == 'i' && size == 4:
+			samples[i] = float64(int32(order.Uint32(b))) // #nosec G115
+		case kind == 'i' && size == 8:
+			samples[i] = float64(int64(order.Uint64(b))) // #nosec G115
+		case kind == 'u' && size == 1:
+			samples[i] = float64(b[0])
+		case kind == 'u' && size == 2:
+			samples[i] = float64(order.Uint16(b))
+		case kind == 'u' && size == 4:
+			samples[i] = float64(order.Uint32(b))
+		default:
+			return nil, fmt.Errorf("unsupported .npy dtype %s", descr[0])
+		}
+	}
+	return samples, nil
+}
+
+// normalize scales the samples so they span exactly -1 to +1, which is the
+// full-scale range of the generator's arbitrary waveform memory. The
+// programmed amplitude is then the peak-to-peak voltage of the waveform.
+func normalize(samples []float64) ([]float64, error) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range samples {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.New("samples contain NaN or Inf values")
+		}
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if hi == lo {
+		return nil, errors.New("samples are constant and can't be normalized")
+	}
+	out := make([]float64, len(samples))
+	for i, v := range samples {
+		out[i] = 2*(v-lo)/(hi-lo) - 1
+	}
+	return out, nil
+}
+
+// resample linearly interpolates the samples, which are treated as one period
+// of a repeating waveform, to n points.
+func resample(samples []float64, n int) []float64 {
+	if n == len(samples) {
+		return samples
+	}
+	out := make([]float64, n)
+	step := float64(len(samples)) / float64(n)
+	for i := range out {
+		pos := float64(i) * step
+		j := int(pos)
+		frac := pos - float64(j)
+		next := samples[(j+1)%len(samples)]
+		out[i] = samples[j] + frac*(next-samples[j])
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi"
+)
+
+// arbLimits describes the arbitrary waveform memory of a function generator
+// model.
+type arbLimits struct {
+	minPoints  int
+	maxPoints  int
+	channels   int
+	sampleRate bool // true if the arb is played at a sample rate instead of a frequency
+}
+
+// limitsForModel returns the arbitrary waveform limits for the given 33200 or
+// 33500 series model as reported by the instrument.
+func limitsForModel(model string) (arbLimits, error) {
+	switch {
+	case strings.Contains(model, "3322"), strings.Contains(model, "3321"):
+		// The 33210A and 33220A store 2 to 64 Ki points per waveform in
+		// volatile memory and play the whole waveform once per period.
+		return arbLimits{minPoints: 2, maxPoints: 65536, channels: 1}, nil
+	case strings.Contains(model, "3351"), strings.Contains(model, "3352"):
+		// The 33500B series stores 8 to 1 Mi points per waveform (without the
+		// memory option) and plays the waveform at a programmed sample rate.
+		channels := 1
+		if strings.HasSuffix(model, "2B") || strings.HasSuffix(model, "2A") {
+			channels = 2
+		}
+		return arbLimits{
+			minPoints:  8,
+			maxPoints:  1 << 20,
+			channels:   channels,
+			sampleRate: true,
+		}, nil
+	}
+	return arbLimits{}, fmt.Errorf("no arbitrary waveform limits known for model %q", model)
+}
+
+// targetPoints returns the number of points to resample a waveform with n
+// samples to, honoring the requested number of points if non-zero.
+func (l arbLimits) targetPoints(n, requested int) int {
+	if requested > 0 {
+		n = requested
+	}
+	return min(max(n, l.minPoints), l.maxPoints)
+}
+
+// arbWaveformCreator is implemented by drivers that support the IVI
+// arbitrary waveform extension, which stores the waveform in the instrument
+// and returns a handle to it.
+type arbWaveformCreator interface {
+	CreateArbWaveform(data []float64) (int, error)
+}
+
+// arbWaveformConfigurer is implemented by channels that support the IVI
+// arbitrary waveform extension.
+type arbWaveformConfigurer interface {
+	ConfigureArbWaveform(handle int, gain, offset float64) error
+}
+
+// arbFrequencySetter is implemented by channels that support the IVI
+// arbitrary frequency extension.
+type arbFrequencySetter interface {
+	SetArbFrequency(freq float64) error
+}
+
+// arbWaveform is the waveform to upload along with how it is to be played.
+type arbWaveform struct {
+	name      string
+	data      []float64
+	frequency float64 // waveform repetition rate in Hz
+	amplitude float64 // peak-to-peak voltage
+	offset    float64 // DC offset voltage
+}
+
+// uploadWithIVI uploads and selects the waveform using the IVI arbitrary
+// waveform extension. The returned bool is false if the driver or channel
+// doesn't implement the extension, in which case nothing was sent.
+func uploadWithIVI(drv, ch any, wfm arbWaveform) (bool, error) {
+	creator, ok := drv.(arbWaveformCreator)
+	if !ok {
+		return false, nil
+	}
+	configurer, ok := ch.(arbWaveformConfigurer)
+	if !ok {
+		return false, nil
+	}
+	freqSetter, ok := ch.(arbFrequencySetter)
+	if !ok {
+		return false, nil
+	}
+	handle, err := creator.CreateArbWaveform(wfm.data)
+	if err != nil {
+		return true, fmt.Errorf("error creating arb waveform: %w", err)
+	}
+	// The IVI gain scales the normalized data, so half the peak-to-peak
+	// amplitude gives the requested amplitude.
+	if err = configurer.ConfigureArbWaveform(handle, wfm.amplitude/2, wfm.offset); err != nil {
+		return true, fmt.Errorf("error configuring arb waveform: %w", err)
+	}
+	if err = freqSetter.SetArbFrequency(wfm.frequency); err != nil {
+		return true, fmt.Errorf("error setting arb frequency: %w", err)
+	}
+	return true, nil
+}
+
+// minUploadRate is the slowest transfer rate in bytes per second allowed for
+// when bounding a command carrying waveform data.
+const minUploadRate = 100_000
+
+// uploadWithSCPI uploads and selects the waveform on the 1-based channel using
+// the model's SCPI DATA commands for drivers lacking the IVI arbitrary
+// waveform extension. Each command is bounded by timeout plus the time to send
+// it at minUploadRate, so an upload of a million points isn't cut short.
+func uploadWithSCPI(
+	ctx context.Context,
+	dev ivi.Transport,
+	limits arbLimits,
+	channel int,
+	wfm arbWaveform,
+	timeout time.Duration,
+) error {
+	values := formatSamples(wfm.data)
+	var cmds []string
+	if limits.sampleRate {
+		src := fmt.Sprintf("SOUR%d:", channel)
+		cmds = []string{
+			src + "DATA:VOL:CLE",
+			fmt.Sprintf("%sDATA:ARB %s,%s", src, wfm.name, values),
+			fmt.Sprintf("%sFUNC:ARB %s", src, wfm.name),
+			src + "FUNC ARB",
+			fmt.Sprintf("%sFUNC:ARB:SRAT %g", src, wfm.frequency*float64(len(wfm.data))),
+			fmt.Sprintf("%sVOLT %g", src, wfm.amplitude),
+			fmt.Sprintf("%sVOLT:OFFS %g", src, wfm.offset),
+		}
+	} else {
+		cmds = []string{
+			"DATA VOLATILE," + values,
+			fmt.Sprintf("DATA:COPY %s,VOLATILE", wfm.name),
+			"FUNC:USER " + wfm.name,
+			"FUNC USER",
+			fmt.Sprintf("FREQ %g", wfm.frequency),
+			fmt.Sprintf("VOLT %g", wfm.amplitude),
+			fmt.Sprintf("VOLT:OFFS %g", wfm.offset),
+		}
+	}
+	for _, cmd := range cmds {
+		d := timeout + time.Duration(len(cmd))*time.Second/minUploadRate
+		cmdCtx, cancel := context.WithTimeout(ctx, d)
+		err := dev.Command(cmdCtx, cmd)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error sending %.40q: %w", cmd, err)
+		}
+	}
+	return nil
+}
+
+// formatSamples formats the normalized samples as a comma separated list with
+// enough resolution for the generators' 14- and 16-bit DACs.
+func formatSamples(data []float64) string {
+	var sb strings.Builder
+	sb.Grow(9 * len(data))
+	for i, v := range data {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(v, 'f', 5, 64))
+	}
+	return sb.String()
+}
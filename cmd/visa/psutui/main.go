@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+func main() {
+	log.Println("IVI Power Supply Dashboard Application")
+
+	var (
+		address  string
+		driver   string
+		timeout  time.Duration
+		interval time.Duration
+	)
+	flag.StringVar(
+		&address,
+		"visa",
+		"TCPIP0::192.168.1.100::5025::SOCKET",
+		"VISA address of the power supply",
+	)
+	flag.StringVar(&driver, "driver", "e36000", "IVI driver of the power supply, e36000 or pmx")
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "I/O timeout")
+	flag.DurationVar(&interval, "interval", 500*time.Millisecond, "Time between polls of the power supply")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t, err := bench.OpenTransport(ctx, address, nil)
+	cancel()
+	if err != nil {
+		log.Fatalf("error opening %s: %s", address, err)
+	}
+	defer func() {
+		if err := t.Close(); err != nil {
+			log.Printf("error closing %s: %s", address, err)
+		}
+	}()
+
+	// The supply isn't reset, so the dashboard shows it as it was left.
+	d, err := bench.NewDriver(driver, t, ivi.WithTimeout(timeout))
+	if err != nil {
+		log.Fatalf("IVI instrument error: %s", err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.Printf("error closing IVI driver: %s", err)
+		}
+	}()
+	psu, ok := d.(bench.DCPwr)
+	if !ok {
+		log.Printf("%s isn't a DC power supply driver", driver)
+		return
+	}
+	instModel, err := psu.InstrumentModel()
+	if err != nil {
+		log.Printf("error querying instrument model: %s", err)
+	}
+	sn, err := psu.InstrumentSerialNumber()
+	if err != nil {
+		log.Printf("error querying instrument sn: %s", err)
+	}
+	s, err := newSupply(psu)
+	if err != nil {
+		log.Printf("error opening channels: %s", err)
+		return
+	}
+
+	m := model{
+		s:        s,
+		title:    fmt.Sprintf("%s S/N %s at %s", instModel, sn, address),
+		interval: interval,
+		polling:  true,
+	}
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		log.Printf("error running dashboard: %s", err)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/tui"
+)
+
+// pollMsg carries the result of a poll.
+type pollMsg struct {
+	status []channelStatus
+	at     time.Time
+}
+
+// tickMsg starts the next poll. Ticks from a superseded schedule are
+// ignored, so a poll run right after a change doesn't start a second one.
+type tickMsg struct{ seq int }
+
+// doneMsg reports the result of a change made from the keyboard.
+type doneMsg struct {
+	desc string
+	err  error
+}
+
+// model is the Bubble Tea model of the dashboard.
+type model struct {
+	s        *supply
+	title    string
+	interval time.Duration
+	status   []channelStatus
+	updated  time.Time
+	selected int
+	polling  bool
+	seq      int
+	prompt   tui.Prompt
+	message  string
+	err      error
+}
+
+var help = tui.Help(
+	tui.Binding{Key: "↑/↓", Desc: "select"},
+	tui.Binding{Key: "v", Desc: "voltage"},
+	tui.Binding{Key: "c", Desc: "current limit"},
+	tui.Binding{Key: "p", Desc: "OVP limit"},
+	tui.Binding{Key: "P", Desc: "toggle OVP"},
+	tui.Binding{Key: "o", Desc: "toggle output"},
+	tui.Binding{Key: "q", Desc: "quit"},
+)
+
+// Init starts the first poll; the model is created with polling set.
+func (m model) Init() tea.Cmd { return m.poll() }
+
+func (m model) poll() tea.Cmd {
+	return func() tea.Msg {
+		return pollMsg{status: m.s.poll(), at: time.Now()}
+	}
+}
+
+// change runs f on the selected channel.
+func (m model) change(desc string, f func(ch bench.DCPwrChannel) error) tea.Cmd {
+	i := m.selected
+	return func() tea.Msg {
+		return doneMsg{desc: desc, err: m.s.do(i, f)}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case pollMsg:
+		m.status, m.updated = msg.status, msg.at
+		m.polling = false
+		m.seq++
+		seq := m.seq
+		return m, tea.Tick(m.interval, func(time.Time) tea.Msg { return tickMsg{seq} })
+	case tickMsg:
+		if msg.seq != m.seq || m.polling {
+			return m, nil
+		}
+		m.polling = true
+		return m, m.poll()
+	case doneMsg:
+		m.message, m.err = msg.desc, msg.err
+		if m.polling {
+			return m, nil
+		}
+		// Show the effect of the change right away.
+		m.polling = true
+		return m, m.poll()
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.prompt.Active() {
+			cmd := m.prompt.Update(msg)
+			return m, cmd
+		}
+		return m.key(msg)
+	}
+	if m.prompt.Active() {
+		cmd := m.prompt.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// key handles a key press while no prompt is open.
+func (m model) key(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if len(m.status) == 0 {
+		if msg.String() == "q" {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	st := m.status[m.selected]
+	switch msg.String() {
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		m.selected = (m.selected + len(m.status) - 1) % len(m.status)
+	case "down", "j", "tab":
+		m.selected = (m.selected + 1) % len(m.status)
+	case "v":
+		cmd := m.prompt.Open(st.name+" voltage (V)", st.voltage, func(v float64) tea.Cmd {
+			return m.change(fmt.Sprintf("set %s voltage to %g V", st.name, v), func(ch bench.DCPwrChannel) error {
+				return ch.SetVoltageLevel(v)
+			})
+		})
+		return m, cmd
+	case "c":
+		cmd := m.prompt.Open(st.name+" current limit (A)", st.currentLimit, func(a float64) tea.Cmd {
+			return m.change(fmt.Sprintf("set %s current limit to %g A", st.name, a), func(ch bench.DCPwrChannel) error {
+				return ch.SetCurrentLimit(a)
+			})
+		})
+		return m, cmd
+	case "p":
+		cmd := m.prompt.Open(st.name+" OVP limit (V)", st.ovpLimit, func(v float64) tea.Cmd {
+			return m.change(fmt.Sprintf("set %s OVP limit to %g V", st.name, v), func(ch bench.DCPwrChannel) error {
+				return ch.ConfigureOVP(st.ovpEnabled, v)
+			})
+		})
+		return m, cmd
+	case "P":
+		return m, m.change(fmt.Sprintf("turn %s OVP %s", st.name, onOff(!st.ovpEnabled)), func(ch bench.DCPwrChannel) error {
+			return ch.ConfigureOVP(!st.ovpEnabled, st.ovpLimit)
+		})
+	case "o":
+		return m, m.change(fmt.Sprintf("turn %s output %s", st.name, onOff(!st.output)), func(ch bench.DCPwrChannel) error {
+			return ch.SetOutputEnabled(!st.output)
+		})
+	}
+	return m, nil
+}
+
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+const rowFormat = "%-10s %10s %10s %14s %10s %10s  %-4s  %-3s"
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(tui.Title.Render(m.title))
+	if !m.updated.IsZero() {
+		b.WriteString(tui.Faint.Render("  updated " + m.updated.Format(time.TimeOnly)))
+	}
+	b.WriteString("\n\n")
+	if m.status == nil {
+		b.WriteString("Reading the power supply...\n")
+		return b.String()
+	}
+	b.WriteString(tui.Header.Render(fmt.Sprintf(rowFormat,
+		"Channel", "Set V", "I limit", "OVP", "Meas V", "Meas I", "Mode", "Out")))
+	b.WriteString("\n")
+	for i, st := range m.status {
+		ovp := fmt.Sprintf("%.3f V off", st.ovpLimit)
+		if st.ovpEnabled {
+			ovp = fmt.Sprintf("%.3f V on ", st.ovpLimit)
+		}
+		row := fmt.Sprintf(rowFormat,
+			st.name,
+			fmt.Sprintf("%.3f V", st.voltage),
+			fmt.Sprintf("%.3f A", st.currentLimit),
+			ovp,
+			fmt.Sprintf("%.3f V", st.measuredVoltage),
+			fmt.Sprintf("%.3f A", st.measuredCurrent),
+			st.mode,
+			strings.ToUpper(onOff(st.output)),
+		)
+		switch {
+		case i == m.selected:
+			row = tui.Selected.Render(row)
+		case st.mode == "OV" || st.mode == "OC" || st.mode == "UR":
+			row = tui.Warn.Render(row)
+		case st.output:
+			row = tui.On.Render(row)
+		}
+		b.WriteString(row + "\n")
+	}
+	b.WriteString("\n")
+	for _, st := range m.status {
+		if st.err != nil {
+			b.WriteString(tui.Error.Render(fmt.Sprintf("%s: %s", st.name, st.err)) + "\n")
+		}
+	}
+	switch {
+	case m.prompt.Active():
+		b.WriteString(m.prompt.View())
+	case m.err != nil:
+		b.WriteString(tui.Error.Render(fmt.Sprintf("%s: %s", m.message, m.err)))
+	case m.message != "":
+		b.WriteString(tui.Faint.Render(m.message + ": ok"))
+	}
+	b.WriteString("\n\n" + help + "\n")
+	return b.String()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dcpwr"
+)
+
+// modes are the output states shown in the mode column, in the order
+// they're checked, with their abbreviations.
+var modes = []struct {
+	state dcpwr.OutputState
+	abbr  string
+}{
+	{dcpwr.OverVoltage, "OV"},
+	{dcpwr.OverCurrent, "OC"},
+	{dcpwr.ConstantCurrent, "CC"},
+	{dcpwr.ConstantVoltage, "CV"},
+	{dcpwr.Unregulated, "UR"},
+}
+
+// channelStatus is the state of one output read by a poll.
+type channelStatus struct {
+	name            string
+	voltage         float64
+	currentLimit    float64
+	ovpEnabled      bool
+	ovpLimit        float64
+	measuredVoltage float64
+	measuredCurrent float64
+	mode            string // abbreviation from modes, or "" if none is active
+	output          bool
+	err             error
+}
+
+// supply serializes access to the power supply, since Bubble Tea runs
+// commands concurrently.
+type supply struct {
+	mu          sync.Mutex
+	psu         bench.DCPwr
+	channels    []bench.DCPwrChannel
+	unsupported map[dcpwr.OutputState]bool
+}
+
+func newSupply(psu bench.DCPwr) (*supply, error) {
+	s := supply{psu: psu, unsupported: make(map[dcpwr.OutputState]bool)}
+	for i := range psu.OutputChannelCount() {
+		ch, err := psu.Channel(i)
+		if err != nil {
+			return nil, fmt.Errorf("error getting channel %d: %w", i, err)
+		}
+		s.channels = append(s.channels, ch)
+	}
+	return &s, nil
+}
+
+// poll reads the setpoints and measurements of every channel.
+func (s *supply) poll() []channelStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := make([]channelStatus, len(s.channels))
+	for i, ch := range s.channels {
+		status[i] = s.pollChannel(ch)
+	}
+	return status
+}
+
+func (s *supply) pollChannel(ch bench.DCPwrChannel) channelStatus {
+	st := channelStatus{name: ch.Name()}
+	var (
+		errs []error
+		err  error
+	)
+	if st.voltage, err = ch.VoltageLevel(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying voltage level: %w", err))
+	}
+	if st.currentLimit, err = ch.CurrentLimit(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying current limit: %w", err))
+	}
+	if st.ovpEnabled, err = ch.OVPEnabled(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying OVP enabled: %w", err))
+	}
+	if st.ovpLimit, err = ch.OVPLimit(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying OVP limit: %w", err))
+	}
+	if st.output, err = ch.OutputEnabled(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying output enabled: %w", err))
+	}
+	if st.measuredVoltage, err = ch.MeasureVoltage(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying measured voltage: %w", err))
+	}
+	if st.measuredCurrent, err = ch.MeasureCurrent(); err != nil {
+		errs = append(errs, fmt.Errorf("error querying measured current: %w", err))
+	}
+	for _, m := range modes {
+		if s.unsupported[m.state] {
+			continue
+		}
+		active, err := ch.QueryOutputState(m.state)
+		if errors.Is(err, ivi.ErrFunctionNotSupported) || errors.Is(err, ivi.ErrNotImplemented) {
+			// Don't ask again on every poll.
+			s.unsupported[m.state] = true
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error querying %s state: %w", m.state, err))
+			break
+		}
+		if active {
+			st.mode = m.abbr
+			break
+		}
+	}
+	st.err = errors.Join(errs...)
+	return st
+}
+
+// do runs f on channel i.
+func (s *supply) do(i int, f func(ch bench.DCPwrChannel) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return f(s.channels[i])
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// scpiCommands are the commands offered by tab completion: the IEEE 488.2
+// common commands and the short forms of the SCPI commands used by the
+// instruments in the examples.
+var scpiCommands = []string{
+	// IEEE 488.2 common commands
+	"*CLS", "*ESE", "*ESE?", "*ESR?", "*IDN?", "*LRN?", "*OPC", "*OPC?",
+	"*RST", "*SRE", "*SRE?", "*STB?", "*TRG", "*TST?", "*WAI",
+	// System and status
+	"SYST:ERR?", "SYST:VERS?", "SYST:BEEP", "SYST:LOC", "SYST:REM",
+	"SYST:LOCK:REQ?", "SYST:LOCK:REL", "SYST:LOCK:OWN?",
+	"STAT:PRES", "STAT:OPER?", "STAT:OPER:COND?", "STAT:QUES?", "STAT:QUES:COND?",
+	"DISP:TEXT", "DISP:TEXT:CLE",
+	// DMM
+	"MEAS:VOLT:DC?", "MEAS:VOLT:AC?", "MEAS:CURR:DC?", "MEAS:CURR:AC?",
+	"MEAS:RES?", "MEAS:FRES?", "MEAS:FREQ?", "MEAS:PER?", "MEAS:TEMP?",
+	"CONF?", "CONF:VOLT:DC", "CONF:VOLT:AC", "CONF:CURR:DC", "CONF:CURR:AC",
+	"CONF:RES", "CONF:FRES", "CONF:FREQ",
+	"SENS:FUNC", "SENS:FUNC?", "SENS:VOLT:DC:RANG", "SENS:VOLT:DC:RANG:AUTO",
+	"SENS:VOLT:DC:NPLC", "READ?", "FETC?", "INIT", "ABOR",
+	"TRIG:SOUR", "TRIG:SOUR?", "TRIG:COUN", "TRIG:DEL", "SAMP:COUN",
+	// DC power supply
+	"APPL", "APPL?", "INST:SEL", "INST:NSEL", "INST:SEL?",
+	"VOLT", "VOLT?", "CURR", "CURR?", "MEAS:VOLT?", "MEAS:CURR?",
+	"VOLT:PROT", "VOLT:PROT?", "VOLT:PROT:STAT", "VOLT:PROT:TRIP?", "VOLT:PROT:CLE",
+	"CURR:PROT:STAT", "CURR:PROT:TRIP?", "CURR:PROT:CLE",
+	"OUTP", "OUTP?", "OUTP:STAT", "OUTP:LOAD",
+	// Function generator
+	"FUNC", "FUNC?", "FREQ", "FREQ?", "VOLT:OFFS", "VOLT:OFFS?", "VOLT:UNIT",
+	"PHAS", "PHAS?", "BURS:STAT", "BURS:NCYC", "BURS:MODE", "BURS:INT:PER",
+	"DATA:VOL:CLE", "DATA:ARB", "DATA:CAT?", "FUNC:ARB",
+	"SOUR1:FUNC", "SOUR2:FUNC", "OUTP1", "OUTP2",
+	// Oscilloscope
+	":AUT", ":RUN", ":STOP", ":SING", ":DIG",
+	":CHAN1:DISP", ":CHAN1:SCAL", ":CHAN1:OFFS", ":TIM:SCAL", ":TIM:POS",
+	":TRIG:EDGE:SOUR", ":TRIG:EDGE:LEV", ":TRIG:SWE",
+	":MEAS:FREQ?", ":MEAS:VPP?", ":MEAS:VRMS?", ":MEAS:VAV?",
+	":WAV:SOUR", ":WAV:FORM", ":WAV:POIN", ":WAV:PRE?", ":WAV:DATA?",
+	// Switch matrix
+	"ROUT:CLOS", "ROUT:CLOS?", "ROUT:OPEN", "ROUT:OPEN:ALL",
+}
+
+// metaCommands are the terminal's own commands.
+var metaCommands = []string{":bin", ":driver", ":err", ":exit", ":help", ":ivi", ":quit", ":timeout"}
+
+// completer returns a term.Terminal AutoCompleteCallback completing the word
+// before the cursor. When several completions are possible, the word is
+// extended as far as they agree and they're listed.
+func (s *session) completer(out io.Writer) func(line string, pos int, key rune) (string, int, bool) {
+	return func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		head := line[:pos]
+		start := strings.LastIndexAny(head, " ;") + 1
+		word := head[start:]
+		candidates := s.candidates(head[:start], word)
+		var matches []string
+		for _, c := range candidates {
+			if !slices.Contains(metaCommands, c) {
+				// The leading colon of a SCPI command is optional, so use the
+				// word's.
+				c = strings.TrimPrefix(c, ":")
+				if strings.HasPrefix(word, ":") {
+					c = ":" + c
+				}
+			}
+			if len(c) >= len(word) && strings.EqualFold(c[:len(word)], word) {
+				matches = append(matches, c)
+			}
+		}
+		if len(matches) == 0 {
+			return "", 0, false
+		}
+		slices.Sort(matches)
+		matches = slices.Compact(matches)
+		completion := commonPrefix(matches)
+		if len(matches) > 1 && len(completion) == len(word) {
+			fmt.Fprintln(out, strings.Join(matches, "  "))
+			return "", 0, false
+		}
+		newHead := head[:start] + completion
+		return newHead + line[pos:], len(newHead), true
+	}
+}
+
+// candidates returns the completions for a word following before.
+func (s *session) candidates(before, word string) []string {
+	fields := strings.Fields(before)
+	switch {
+	case len(fields) == 0 && strings.HasPrefix(word, ":") && strings.ToLower(word) == word:
+		return slices.Concat(metaCommands, scpiCommands)
+	case len(fields) == 0:
+		return scpiCommands
+	case fields[0] == ":driver":
+		return driverNames()
+	case fields[0] == ":ivi":
+		return methodNames(s.drv)
+	case fields[0] == ":bin":
+		return scpiCommands
+	}
+	if strings.HasSuffix(strings.TrimSpace(before), ";") {
+		return scpiCommands
+	}
+	return nil
+}
+
+// commonPrefix returns the longest prefix shared by the strings, using the
+// case of the first.
+func commonPrefix(ss []string) string {
+	prefix := ss[0]
+	for _, s := range ss[1:] {
+		n := 0
+		for n < len(prefix) && n < len(s) && strings.EqualFold(prefix[n:n+1], s[n:n+1]) {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	return prefix
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// maxHistory is the number of history entries loaded.
+const maxHistory = 1000
+
+// history implements term.History, appending each entry to a file so the
+// history carries over between sessions.
+type history struct {
+	entries []string // oldest first
+	f       *os.File
+}
+
+func openHistory(name string) (*history, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	h := history{f: f}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if sc.Text() != "" {
+			h.entries = append(h.entries, sc.Text())
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	if len(h.entries) > maxHistory {
+		h.entries = h.entries[len(h.entries)-maxHistory:]
+	}
+	return &h, nil
+}
+
+// Add adds an entry unless it repeats the last one.
+func (h *history) Add(entry string) {
+	if n := len(h.entries); n > 0 && h.entries[n-1] == entry {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	_, _ = fmt.Fprintln(h.f, entry)
+}
+
+func (h *history) Len() int { return len(h.entries) }
+
+// At returns the entry idx back from the most recent.
+func (h *history) At(idx int) string { return h.entries[len(h.entries)-1-idx] }
+
+func (h *history) Close() error { return h.f.Close() }
@@ -0,0 +1,241 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+// The :ivi command calls driver methods using Go syntax, e.g.,
+// Channel(0).SetVoltageLevel(5). The parentheses of a call without arguments
+// may be left out. Arguments are numbers, strings, true or false, durations
+// written as strings, e.g., "2s", or the names of enum values, e.g., DCVolts
+// or dmm.DCVolts.
+
+var (
+	errorType    = reflect.TypeFor[error]()
+	durationType = reflect.TypeFor[time.Duration]()
+	stringerType = reflect.TypeFor[fmt.Stringer]()
+)
+
+// unwrap returns the driver behind a bench class adapter so all of its
+// methods can be called.
+func unwrap(d bench.Inherent) any {
+	if u, ok := d.(interface{ Unwrap() bench.Inherent }); ok {
+		return u.Unwrap()
+	}
+	return d
+}
+
+func driverNames() []string { return bench.Drivers() }
+
+// methodNames returns the names of the driver's methods.
+func methodNames(drv any) []string {
+	if drv == nil {
+		return nil
+	}
+	t := reflect.TypeOf(drv)
+	names := make([]string, t.NumMethod())
+	for i := range t.NumMethod() {
+		names[i] = t.Method(i).Name
+	}
+	return names
+}
+
+// callIVI lists the driver's methods or evaluates a call expression.
+func (s *session) callIVI(expr string) {
+	if s.drv == nil {
+		fmt.Fprintln(s.out, "no driver selected; use :driver <name>")
+		return
+	}
+	if expr == "" {
+		v := reflect.ValueOf(s.drv)
+		for i := range v.NumMethod() {
+			fmt.Fprintf(s.out, "%s%s\n", v.Type().Method(i).Name,
+				strings.TrimPrefix(v.Method(i).Type().String(), "func"))
+		}
+		return
+	}
+	start := time.Now()
+	results, err := evalIVI(s.drv, expr)
+	d := elapsed(start)
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %s (%s)\n", err, d)
+		return
+	}
+	for _, r := range results {
+		fmt.Fprintf(s.out, "%v\n", r)
+	}
+	fmt.Fprintf(s.out, "ok (%s)\n", d)
+}
+
+// evalIVI evaluates a chain of method calls on the driver, returning the
+// results of the last call other than a nil error.
+func evalIVI(drv any, expr string) ([]any, error) {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression: %w", err)
+	}
+	return eval(reflect.ValueOf(drv), e)
+}
+
+func eval(drv reflect.Value, e ast.Expr) ([]any, error) {
+	var (
+		fun  ast.Expr = e
+		args []ast.Expr
+	)
+	if call, ok := e.(*ast.CallExpr); ok {
+		fun, args = call.Fun, call.Args
+	}
+	recv := drv
+	var name string
+	switch f := fun.(type) {
+	case *ast.Ident:
+		name = f.Name
+	case *ast.SelectorExpr:
+		results, err := eval(drv, f.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) != 1 {
+			return nil, fmt.Errorf("can't call %s on %d values", f.Sel.Name, len(results))
+		}
+		recv, name = reflect.ValueOf(results[0]), f.Sel.Name
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", fun)
+	}
+	return call(recv, name, args)
+}
+
+// call calls the named method, matching its name without regard to case.
+func call(recv reflect.Value, name string, args []ast.Expr) ([]any, error) {
+	if !recv.IsValid() || recv.Kind() == reflect.Pointer && recv.IsNil() {
+		return nil, fmt.Errorf("can't call %s on nil", name)
+	}
+	m := recv.MethodByName(name)
+	if !m.IsValid() {
+		for i := range recv.NumMethod() {
+			if strings.EqualFold(recv.Type().Method(i).Name, name) {
+				m = recv.Method(i)
+				break
+			}
+		}
+	}
+	if !m.IsValid() {
+		return nil, fmt.Errorf("%s has no method %s", recv.Type(), name)
+	}
+	mt := m.Type()
+	if mt.IsVariadic() || mt.NumIn() != len(args) {
+		return nil, fmt.Errorf("%s takes %d arguments%s", name, mt.NumIn(), strings.TrimPrefix(mt.String(), "func"))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		v, err := argValue(arg, mt.In(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s argument %d: %w", name, i+1, err)
+		}
+		in[i] = v
+	}
+	var results []any
+	for i, out := range m.Call(in) {
+		if mt.Out(i) == errorType {
+			if !out.IsNil() {
+				return nil, out.Interface().(error)
+			}
+			continue
+		}
+		results = append(results, out.Interface())
+	}
+	return results, nil
+}
+
+// argValue converts an argument expression to the parameter type.
+func argValue(e ast.Expr, t reflect.Type) (reflect.Value, error) {
+	lit := literal(e)
+	v := reflect.New(t).Elem()
+	switch {
+	case t == durationType:
+		d, err := time.ParseDuration(lit)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(int64(d))
+	case t.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(lit)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case t.Kind() == reflect.Int && t.Implements(stringerType):
+		if n, err := strconv.Atoi(lit); err == nil {
+			v.SetInt(int64(n))
+			break
+		}
+		if err := enumValue(v, lit); err != nil {
+			return v, err
+		}
+	case v.CanInt():
+		n, err := strconv.ParseInt(lit, 0, 64)
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(n)
+	case v.CanFloat():
+		f, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	case t.Kind() == reflect.String:
+		v.SetString(lit)
+	default:
+		return v, fmt.Errorf("unsupported parameter type %s", t)
+	}
+	return v, nil
+}
+
+// literal returns the text of an argument, unquoting strings and dropping
+// the package name of an enum such as dmm.DCVolts.
+func literal(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind == token.STRING {
+			if s, err := strconv.Unquote(e.Value); err == nil {
+				return s
+			}
+		}
+		return e.Value
+	case *ast.UnaryExpr:
+		return e.Op.String() + literal(e.X)
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+// enumValue sets v to the enum value whose String method returns name,
+// ignoring case, as bench.ParseEnum does.
+func enumValue(v reflect.Value, name string) error {
+	for i := range 64 {
+		v.SetInt(int64(i))
+		if strings.EqualFold(v.Interface().(fmt.Stringer).String(), name) {
+			return nil
+		}
+	}
+	return errors.New("unknown " + v.Type().String() + " " + strconv.Quote(name))
+}
@@ -0,0 +1,328 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/errqueue"
+	"github.com/gotmc/ivi-examples/internal/scpi"
+	"golang.org/x/term"
+)
+
+const help = `Lines are sent to the instrument as is. A line ending in '?' is sent as a
+query and the response printed; binary block responses are shown as a hex
+dump. Tab completes common SCPI commands. Ctrl-D or Ctrl-C quits.
+
+  :bin <query> [file]   read the binary block returned by a query, e.g.,
+                        :bin :WAV:DATA? wave.bin
+  :err                  read and clear the instrument's error queue
+  :timeout [duration]   show or set the I/O timeout, e.g., :timeout 10s
+  :driver [name]        show or select the IVI driver used by :ivi
+  :ivi [expr]           list the driver's methods or call them, e.g.,
+                        :ivi InstrumentModel
+                        :ivi Channel(0).SetVoltageLevel(5)
+                        :ivi SetMeasurementFunction(DCVolts)
+  :help                 show this help
+  :quit                 quit
+`
+
+// maxDump is the number of bytes of a binary block shown in a hex dump.
+const maxDump = 256
+
+// session is the state of the terminal.
+type session struct {
+	t       ivi.Transport
+	out     io.Writer
+	timeout time.Duration
+	driver  string
+	drv     any
+}
+
+// lineReader is implemented by term.Terminal and by stdinLines when the
+// input isn't a terminal.
+type lineReader interface {
+	ReadLine() (string, error)
+}
+
+type stdinLines struct{ s *bufio.Scanner }
+
+func (l stdinLines) ReadLine() (string, error) {
+	if !l.s.Scan() {
+		if err := l.s.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return l.s.Text(), nil
+}
+
+func main() {
+	log.Println("IVI SCPI Terminal Application")
+
+	var (
+		address  string
+		port     string
+		gpibAddr int
+		driver   string
+		timeout  time.Duration
+		history  string
+	)
+	home, _ := os.UserHomeDir()
+	flag.StringVar(
+		&address,
+		"visa",
+		"TCPIP0::192.168.1.100::5025::SOCKET",
+		"VISA address of the instrument",
+	)
+	flag.StringVar(&port, "prologix", "", "Serial port of a Prologix GPIB-USB controller to use instead of -visa")
+	flag.IntVar(&gpibAddr, "gpib", 5, "GPIB address of the instrument when using -prologix")
+	flag.StringVar(
+		&driver,
+		"driver",
+		"",
+		"IVI driver for :ivi, one of "+strings.Join(bench.Drivers(), ", "),
+	)
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "I/O timeout")
+	flag.StringVar(
+		&history,
+		"history",
+		filepath.Join(home, ".ivi_scpi_history"),
+		"File to keep the command history in (empty = don't keep)",
+	)
+	flag.Parse()
+
+	var prologix *bench.PrologixConfig
+	if port != "" {
+		prologix = &bench.PrologixConfig{Port: port, GPIB: gpibAddr}
+		address = fmt.Sprintf("%s GPIB %d", port, gpibAddr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t, err := bench.OpenTransport(ctx, address, prologix)
+	cancel()
+	if err != nil {
+		log.Fatalf("error opening %s: %s", address, err)
+	}
+	defer func() {
+		if err := t.Close(); err != nil {
+			log.Printf("error closing %s: %s", address, err)
+		}
+	}()
+
+	s := session{t: t, out: os.Stdout, timeout: timeout}
+	var lines lineReader = stdinLines{bufio.NewScanner(os.Stdin)}
+	fd := int(os.Stdin.Fd())
+	if term.IsTerminal(fd) {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			log.Fatalf("error setting up terminal: %s", err)
+		}
+		defer func() {
+			if err := term.Restore(fd, state); err != nil {
+				log.Printf("error restoring terminal: %s", err)
+			}
+		}()
+		tm := term.NewTerminal(struct {
+			io.Reader
+			io.Writer
+		}{os.Stdin, os.Stdout}, "scpi> ")
+		if w, h, err := term.GetSize(fd); err == nil {
+			_ = tm.SetSize(w, h)
+		}
+		if history != "" {
+			h, err := openHistory(history)
+			if err != nil {
+				fmt.Fprintf(tm, "error opening history: %s\n", err)
+			} else {
+				defer h.Close()
+				tm.History = h
+			}
+		}
+		tm.AutoCompleteCallback = s.completer(tm)
+		// The terminal translates newlines for raw mode, so log through it.
+		log.SetOutput(tm)
+		s.out = tm
+		lines = tm
+		fmt.Fprintf(tm, "Connected to %s. Type :help for help.\n", address)
+	}
+	if driver != "" {
+		s.selectDriver(driver)
+	}
+
+	for {
+		line, err := lines.ReadLine()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				fmt.Fprintf(s.out, "error reading input: %s\n", err)
+			}
+			return
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !s.handle(line) {
+			return
+		}
+	}
+}
+
+// handle runs one line, returning false to quit.
+func (s *session) handle(line string) bool {
+	cmd, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+	switch cmd {
+	case ":quit", ":exit":
+		return false
+	case ":help":
+		fmt.Fprint(s.out, help)
+	case ":timeout":
+		if arg != "" {
+			d, err := time.ParseDuration(arg)
+			if err != nil || d <= 0 {
+				fmt.Fprintf(s.out, "invalid timeout %q\n", arg)
+				break
+			}
+			s.timeout = d
+			if s.driver != "" {
+				s.selectDriver(s.driver)
+			}
+		}
+		fmt.Fprintf(s.out, "timeout = %s\n", s.timeout)
+	case ":err":
+		s.readErrors()
+	case ":bin":
+		query, file, _ := strings.Cut(arg, " ")
+		s.readBlock(query, strings.TrimSpace(file))
+	case ":driver":
+		if arg == "" {
+			fmt.Fprintf(s.out, "driver = %q; available: %s\n", s.driver, strings.Join(bench.Drivers(), ", "))
+			break
+		}
+		s.selectDriver(arg)
+	case ":ivi":
+		s.callIVI(arg)
+	default:
+		s.send(line)
+	}
+	return true
+}
+
+func (s *session) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+// send sends a line as a query if it ends in '?' and as a command otherwise.
+func (s *session) send(line string) {
+	ctx, cancel := s.context()
+	defer cancel()
+	start := time.Now()
+	if !strings.HasSuffix(line, "?") {
+		if err := s.t.Command(ctx, line); err != nil {
+			fmt.Fprintf(s.out, "error: %s\n", err)
+			return
+		}
+		fmt.Fprintf(s.out, "ok (%s)\n", elapsed(start))
+		return
+	}
+	resp, err := s.t.Query(ctx, line)
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %s (%s)\n", err, elapsed(start))
+		return
+	}
+	d := elapsed(start)
+	if scpi.IsBlock([]byte(resp)) {
+		data, _, err := scpi.ParseBlock([]byte(resp))
+		if err != nil {
+			fmt.Fprintf(s.out, "binary block, %s; try :bin %s\n", err, line)
+			return
+		}
+		s.dump(data)
+		fmt.Fprintf(s.out, "(%d bytes, %s)\n", len(data), d)
+		return
+	}
+	fmt.Fprintf(s.out, "%s\n(%s)\n", strings.TrimRight(resp, "\r\n"), d)
+}
+
+// readBlock reads a binary block, showing a hex dump or saving it to a file.
+func (s *session) readBlock(query, file string) {
+	if query == "" {
+		fmt.Fprintln(s.out, "usage: :bin <query> [file]")
+		return
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	start := time.Now()
+	data, err := scpi.ReadBlock(ctx, s.t, query)
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %s (%s)\n", err, elapsed(start))
+		return
+	}
+	d := elapsed(start)
+	if file != "" {
+		if err := os.WriteFile(file, data, 0o644); err != nil {
+			fmt.Fprintf(s.out, "error: %s\n", err)
+			return
+		}
+		fmt.Fprintf(s.out, "wrote %d bytes to %s (%s)\n", len(data), file, d)
+		return
+	}
+	s.dump(data)
+	fmt.Fprintf(s.out, "(%d bytes, %s)\n", len(data), d)
+}
+
+func (s *session) dump(data []byte) {
+	fmt.Fprint(s.out, hex.Dump(data[:min(len(data), maxDump)]))
+	if len(data) > maxDump {
+		fmt.Fprintf(s.out, "... %d more bytes\n", len(data)-maxDump)
+	}
+}
+
+func (s *session) readErrors() {
+	ctx, cancel := s.context()
+	defer cancel()
+	entries, err := errqueue.Drain(ctx, s.t, errqueue.SCPI)
+	for _, e := range entries {
+		fmt.Fprintf(s.out, "%d,%q\n", e.Code, e.Message)
+	}
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %s\n", err)
+		return
+	}
+	if len(entries) == 0 {
+		fmt.Fprintln(s.out, "no errors")
+	}
+}
+
+// selectDriver creates the driver used by :ivi on the same transport. The
+// instrument isn't reset.
+func (s *session) selectDriver(name string) {
+	d, err := bench.NewDriver(name, s.t, ivi.WithTimeout(s.timeout))
+	if err != nil {
+		fmt.Fprintf(s.out, "error: %s\n", err)
+		return
+	}
+	s.driver = name
+	s.drv = unwrap(d)
+	fmt.Fprintf(s.out, "using %s driver (%T)\n", name, s.drv)
+}
+
+func elapsed(start time.Time) time.Duration {
+	return time.Since(start).Round(time.Microsecond)
+}
@@ -9,18 +9,19 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/instlock"
 	"github.com/gotmc/ivi/fgen"
 	"github.com/gotmc/ivi/fgen/keysight/kt33000"
-	_ "github.com/gotmc/usbtmc/driver/google"
-	"github.com/gotmc/visa"
-	_ "github.com/gotmc/visa/driver/usbtmc"
 )
 
 var (
-	debugLevel uint
-	address    string
+	debugLevel  uint
+	address     string
+	lockTimeout *time.Duration
 )
 
 func init() {
@@ -39,6 +40,7 @@ func init() {
 		"USB0::2391::1031::MY44035849::INSTR",
 		"VISA address of Keysight 33220A",
 	)
+	lockTimeout = instlock.TimeoutFlag()
 }
 
 func main() {
@@ -49,12 +51,20 @@ func main() {
 
 	ctx := context.Background()
 
-	// Configure a new VISA resource using the USBTMC driver.
 	log.Printf("VISA address = %s", address)
-	res, err := visa.NewResource(ctx, address)
+
+	// The bench package takes the generator's lock file along with the resource.
+	openCtx, cancel := context.WithTimeout(ctx, *lockTimeout)
+	res, err := bench.OpenTransport(openCtx, address, nil)
+	cancel()
 	if err != nil {
 		log.Fatalf("VISA resource %s: %s", address, err)
 	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Printf("error closing VISA resource: %s", err)
+		}
+	}()
 
 	// Create a new IVI instance of and reset the Agilent 33220 function
 	// generator using the USBTMC device.
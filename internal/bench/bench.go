@@ -0,0 +1,413 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package bench opens the instruments listed in a JSON configuration file
+// using the IVI drivers from the examples in this repository and serializes
+// access to each instrument, so the instruments can be shared by concurrent
+// clients such as the handlers of a network server.
+//
+// A configuration looks like:
+//
+//	{
+//	  "instruments": [
+//	    {"name": "psu", "driver": "e36000", "address": "TCPIP0::192.168.1.101::5025::SOCKET"},
+//	    {"name": "dmm", "driver": "kt34400", "address": "TCPIP0::192.168.1.102::5025::SOCKET", "reset": true},
+//	    {"name": "f45", "driver": "fluke45", "prologix": {"port": "/dev/ttyUSB0", "gpib": 3}, "timeout": "10s"}
+//	  ]
+//	}
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/broker"
+	"github.com/gotmc/ivi-examples/internal/gpibbus"
+	"github.com/gotmc/ivi-examples/internal/instlock"
+	_ "github.com/gotmc/usbtmc/driver/google"
+	"github.com/gotmc/visa"
+	_ "github.com/gotmc/visa/driver/asrl"
+	_ "github.com/gotmc/visa/driver/tcpip"
+	_ "github.com/gotmc/visa/driver/usbtmc"
+)
+
+// DefaultTimeout bounds each driver operation when the configuration doesn't
+// set a timeout.
+const DefaultTimeout = 5 * time.Second
+
+// Instrument is an open instrument. Its driver and transport must only be
+// used from within Do, which serializes access.
+type Instrument struct {
+	Name    string
+	Driver  string
+	Class   Class
+	Address string // VISA address or Prologix port and GPIB address
+
+	sem      chan struct{}
+	t        ivi.Transport
+	drv      Inherent
+	scpiLock bool // holds the instrument's SYSTem:LOCK
+}
+
+// Do runs fn with exclusive access to the instrument's driver, waiting for
+// any other caller to finish first. The driver can be type asserted to the
+// interface for the instrument's class, e.g., DCPwr. Do returns ctx.Err() if
+// ctx is done before access is granted.
+func (inst *Instrument) Do(ctx context.Context, fn func(drv Inherent) error) error {
+	select {
+	case inst.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-inst.sem }()
+	return fn(inst.drv)
+}
+
+// DCPwrChannelIndex returns the index of the named output channel of a DC
+// power supply. An empty name selects the first channel.
+func (inst *Instrument) DCPwrChannelIndex(ctx context.Context, name string) (int, error) {
+	if inst.Class != ClassDCPwr {
+		return 0, fmt.Errorf("instrument %q is a %s, not a dcpwr", inst.Name, inst.Class)
+	}
+	idx := -1
+	err := inst.Do(ctx, func(drv Inherent) error {
+		ps := drv.(DCPwr)
+		for i := range ps.OutputChannelCount() {
+			ch, err := ps.Channel(i)
+			if err != nil {
+				return err
+			}
+			if name == "" || ch.Name() == name {
+				idx = i
+				return nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error reading %s channels: %w", inst.Name, err)
+	}
+	if idx < 0 {
+		return 0, fmt.Errorf("instrument %q has no channel %q", inst.Name, name)
+	}
+	return idx, nil
+}
+
+// NewInstrument wraps a driver the caller has opened, such as a simulated
+// one, as an instrument of the class. t may be nil if the driver has no
+// transport.
+func NewInstrument(name string, class Class, drv Inherent, t ivi.Transport) *Instrument {
+	return &Instrument{
+		Name:  name,
+		Class: class,
+		sem:   make(chan struct{}, 1),
+		t:     t,
+		drv:   drv,
+	}
+}
+
+// Transport returns the transport to the instrument for sending SCPI commands
+// the IVI driver doesn't provide. Only use it from within Do.
+func (inst *Instrument) Transport() ivi.Transport { return inst.t }
+
+// close closes the driver, returning the instrument to local control, and
+// then the transport.
+func (inst *Instrument) close() error {
+	inst.sem <- struct{}{}
+	defer func() { <-inst.sem }()
+	var errs []error
+	if inst.scpiLock {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		defer cancel()
+		if err := instlock.ReleaseSCPI(ctx, inst.t); err != nil {
+			errs = append(errs, fmt.Errorf("%s: error releasing instrument lock: %w", inst.Name, err))
+		}
+	}
+	if err := inst.drv.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("%s: error closing IVI driver: %w", inst.Name, err))
+	}
+	if inst.t == nil {
+		return errors.Join(errs...)
+	}
+	if err := inst.t.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("%s: error closing transport: %w", inst.Name, err))
+	}
+	return errors.Join(errs...)
+}
+
+// Bench is a set of open instruments.
+type Bench struct {
+	instruments []*Instrument
+	byName      map[string]*Instrument
+}
+
+// Open opens the instruments in cfg concurrently, since opening a VISA
+// resource and resetting an instrument can take seconds each. Instruments
+// behind the same Prologix controller share it; see OpenTransport. If any
+// instrument fails to open, the others are closed again and the errors of all
+// those that failed are returned.
+func Open(ctx context.Context, cfg Config) (*Bench, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	var (
+		insts = make([]*Instrument, len(cfg.Instruments))
+		errs  = make([]error, len(cfg.Instruments))
+		wg    sync.WaitGroup
+	)
+	for i, ic := range cfg.Instruments {
+		wg.Go(func() {
+			if err := ctx.Err(); err != nil {
+				errs[i] = fmt.Errorf("%s: %w", ic.Name, err)
+				return
+			}
+			insts[i], errs[i] = open(ctx, ic)
+		})
+	}
+	wg.Wait()
+
+	b := Bench{byName: make(map[string]*Instrument)}
+	for _, inst := range insts {
+		if inst != nil {
+			b.instruments = append(b.instruments, inst)
+			b.byName[inst.Name] = inst
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, errors.Join(err, b.Close())
+	}
+	return &b, nil
+}
+
+func open(ctx context.Context, ic InstrumentConfig) (*Instrument, error) {
+	inst := Instrument{
+		Name:    ic.Name,
+		Driver:  ic.Driver,
+		Class:   drivers[ic.Driver].class,
+		Address: ic.Address,
+		sem:     make(chan struct{}, 1),
+	}
+	if ic.Prologix != nil {
+		inst.Address = fmt.Sprintf("%s GPIB %d", ic.Prologix.Port, ic.Prologix.GPIB)
+	}
+	var err error
+	inst.t, err = OpenTransport(ctx, ic.Address, ic.Prologix)
+	if err != nil {
+		return nil, fmt.Errorf("%s: error opening %s: %w", ic.Name, inst.Address, err)
+	}
+	if ic.SCPILock {
+		if err := instlock.RequestSCPI(ctx, inst.t); err != nil {
+			return nil, errors.Join(fmt.Errorf("%s: %w", ic.Name, err), inst.t.Close())
+		}
+		inst.scpiLock = true
+	}
+
+	timeout := ic.Timeout.Duration
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	opts := []ivi.Option{ivi.WithTimeout(timeout)}
+	if ic.Reset {
+		opts = append(opts, ivi.WithReset())
+	}
+	inst.drv, err = NewDriver(ic.Driver, inst.t, opts...)
+	if err != nil {
+		errs := []error{fmt.Errorf("%s: IVI instrument error: %w", ic.Name, err)}
+		if inst.scpiLock {
+			errs = append(errs, instlock.ReleaseSCPI(ctx, inst.t))
+		}
+		return nil, errors.Join(append(errs, inst.t.Close())...)
+	}
+	return &inst, nil
+}
+
+// OpenTransport opens the VISA address or, if p isn't nil, the instrument at a
+// GPIB address of a Prologix GPIB-USB controller. It first takes the
+// instrument's lock file, waiting for another process holding it until ctx is
+// done, and closing the transport releases it.
+//
+// The instruments open on a Prologix controller share it through a
+// gpibbus.Bus, which is opened with the first of them and closed with the
+// last. The lock file is then that of the serial port, since another process
+// can't use the controller either.
+//
+// If $IVI_BROKER is set to the socket of a broker daemon, the transport is
+// instead a session on the broker's connection to the instrument, and the
+// broker holds the lock file.
+func OpenTransport(ctx context.Context, address string, p *PrologixConfig) (ivi.Transport, error) {
+	if socket := os.Getenv(broker.SocketEnv); socket != "" {
+		dev := broker.Device{Address: address}
+		if p != nil {
+			dev = broker.Device{Port: p.Port, GPIB: p.GPIB}
+		}
+		return broker.Dial(ctx, socket, dev)
+	}
+	if p != nil {
+		return openPrologix(ctx, *p)
+	}
+	lock, err := instlock.Acquire(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	t, err := visa.NewResource(ctx, address)
+	if err != nil {
+		return nil, errors.Join(err, lock.Release())
+	}
+	return &lockedTransport{Transport: t, lock: lock}, nil
+}
+
+// lockedTransport releases the instrument's lock file when it's closed.
+type lockedTransport struct {
+	ivi.Transport
+	lock *instlock.Lock
+}
+
+func (t *lockedTransport) Close() error {
+	return errors.Join(t.Transport.Close(), t.lock.Release())
+}
+
+// Unwrap returns the underlying transport.
+func (t *lockedTransport) Unwrap() ivi.Transport { return t.Transport }
+
+// sharedBus is a Prologix controller and the lock file of its serial port.
+type sharedBus struct {
+	*gpibbus.Bus
+	port  string
+	lock  *instlock.Lock
+	err   error         // error opening the bus
+	ready chan struct{} // closed once opened
+
+	users int // devices open or being opened on the bus; guarded by busesMu
+}
+
+// buses are the Prologix controllers open in this process by serial port.
+// busesMu is only held to look them up, so opening one, which may wait for
+// the lock file, doesn't hold up the others.
+var (
+	busesMu sync.Mutex
+	buses   = make(map[string]*sharedBus)
+)
+
+func openPrologix(ctx context.Context, cfg PrologixConfig) (ivi.Transport, error) {
+	busesMu.Lock()
+	b, ok := buses[cfg.Port]
+	if !ok {
+		b = &sharedBus{port: cfg.Port, ready: make(chan struct{})}
+		buses[cfg.Port] = b
+	}
+	b.users++
+	busesMu.Unlock()
+
+	if !ok {
+		if b.err = b.open(ctx); b.err != nil {
+			busesMu.Lock()
+			delete(buses, cfg.Port)
+			busesMu.Unlock()
+		}
+		close(b.ready)
+	}
+	select {
+	case <-b.ready:
+	case <-ctx.Done():
+		return nil, errors.Join(ctx.Err(), b.detach())
+	}
+	if b.err != nil {
+		return nil, errors.Join(b.err, b.detach())
+	}
+	dev, err := b.Device(ctx, cfg.GPIB, true)
+	if err != nil {
+		return nil, errors.Join(err, b.detach())
+	}
+	return &busDevice{Device: dev, bus: b}, nil
+}
+
+// open locks the serial port and opens the controller.
+func (b *sharedBus) open(ctx context.Context) error {
+	lock, err := instlock.Acquire(ctx, b.port)
+	if err != nil {
+		return err
+	}
+	bus, err := gpibbus.Open(b.port)
+	if err != nil {
+		return errors.Join(err, lock.Release())
+	}
+	b.Bus, b.lock = bus, lock
+	return nil
+}
+
+// detach ends a use of the bus. After the last, it closes the bus and
+// releases its lock file.
+func (b *sharedBus) detach() error {
+	busesMu.Lock()
+	b.users--
+	last := b.users == 0 && buses[b.port] == b
+	if last {
+		delete(buses, b.port)
+	}
+	busesMu.Unlock()
+	if !last {
+		return nil
+	}
+	return errors.Join(b.Bus.Close(), b.lock.Release())
+}
+
+// busDevice closes its bus when it's the last device open on it.
+type busDevice struct {
+	*gpibbus.Device
+	bus       *sharedBus
+	closeOnce sync.Once
+}
+
+func (d *busDevice) Close() error {
+	var err error
+	d.closeOnce.Do(func() { err = errors.Join(d.Device.Close(), d.bus.detach()) })
+	return err
+}
+
+// New creates a bench of instruments the caller has opened, e.g., with
+// NewInstrument. Closing the bench closes them.
+func New(insts ...*Instrument) *Bench {
+	b := Bench{instruments: insts, byName: make(map[string]*Instrument)}
+	for _, inst := range insts {
+		b.byName[inst.Name] = inst
+	}
+	return &b
+}
+
+// Instrument returns the named instrument.
+func (b *Bench) Instrument(name string) (*Instrument, bool) {
+	inst, ok := b.byName[name]
+	return inst, ok
+}
+
+// Instruments returns the instruments in configuration order.
+func (b *Bench) Instruments() []*Instrument { return b.instruments }
+
+// Wrap replaces the driver of every instrument with the one fn returns, such
+// as a wrapper enforcing limits on the setpoints. The new driver must still
+// implement the interface of the instrument's class. Call Wrap before the
+// instruments are used.
+func (b *Bench) Wrap(fn func(inst *Instrument, drv Inherent) Inherent) {
+	for _, inst := range b.instruments {
+		inst.drv = fn(inst, inst.drv)
+	}
+}
+
+// Close closes all instruments in reverse order, waiting for any operation in
+// progress on each to finish.
+func (b *Bench) Close() error {
+	var errs []error
+	for i := len(b.instruments) - 1; i >= 0; i-- {
+		errs = append(errs, b.instruments[i].close())
+	}
+	b.instruments = nil
+	clear(b.byName)
+	return errors.Join(errs...)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bench
+
+import (
+	"time"
+
+	"github.com/gotmc/ivi/dcpwr"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+)
+
+// Class is an IVI instrument class.
+type Class string
+
+// Supported instrument classes.
+const (
+	ClassDCPwr Class = "dcpwr"
+	ClassDMM   Class = "dmm"
+	ClassFGen  Class = "fgen"
+	ClassScope Class = "scope"
+	ClassSwtch Class = "swtch"
+)
+
+// Inherent holds the IVI inherent capabilities implemented by every driver.
+type Inherent interface {
+	InstrumentManufacturer() (string, error)
+	InstrumentModel() (string, error)
+	InstrumentSerialNumber() (string, error)
+	FirmwareRevision() (string, error)
+	Clear() error
+	Reset() error
+	Close() error
+}
+
+// DCPwr is a DC power supply.
+type DCPwr interface {
+	Inherent
+	OutputChannelCount() int
+	Channel(i int) (DCPwrChannel, error)
+}
+
+// DCPwrChannel is one output of a DC power supply.
+type DCPwrChannel interface {
+	Name() string
+	VoltageLevel() (float64, error)
+	SetVoltageLevel(v float64) error
+	CurrentLimit() (float64, error)
+	SetCurrentLimit(a float64) error
+	OutputEnabled() (bool, error)
+	SetOutputEnabled(b bool) error
+	OVPEnabled() (bool, error)
+	OVPLimit() (float64, error)
+	ConfigureOVP(enabled bool, limit float64) error
+	MeasureVoltage() (float64, error)
+	MeasureCurrent() (float64, error)
+	QueryOutputState(state dcpwr.OutputState) (bool, error)
+}
+
+// DMM is a digital multimeter.
+type DMM interface {
+	Inherent
+	MeasurementFunction() (dmm.MeasurementFunction, error)
+	SetMeasurementFunction(fcn dmm.MeasurementFunction) error
+	Range() (dmm.AutoRange, float64, error)
+	SetRange(autoRange dmm.AutoRange, rng float64) error
+	ReadMeasurement(maxTime time.Duration) (float64, error)
+}
+
+// FGen is a function generator.
+type FGen interface {
+	Inherent
+	ChannelCount() int
+	Channel(i int) (FGenChannel, error)
+}
+
+// FGenChannel is one output of a function generator.
+type FGenChannel interface {
+	Name() string
+	OutputEnabled() (bool, error)
+	EnableOutput() error
+	DisableOutput() error
+	StandardWaveform() (fgen.StandardWaveform, error)
+	SetStandardWaveform(wave fgen.StandardWaveform) error
+	Frequency() (float64, error)
+	SetFrequency(freq float64) error
+	Amplitude() (float64, error)
+	SetAmplitude(amp float64) error
+	DCOffset() (float64, error)
+	SetDCOffset(offset float64) error
+	StartPhase() (float64, error)
+	ConfigureStandardWaveform(
+		wave fgen.StandardWaveform,
+		amp, offset, freq, phase float64,
+	) error
+}
+
+// Scope is an oscilloscope.
+type Scope interface {
+	Inherent
+	ChannelCount() int
+	Channel(i int) (ScopeChannel, error)
+}
+
+// ScopeChannel is one input of an oscilloscope.
+type ScopeChannel interface {
+	Name() string
+	SetChannelEnabled(b bool) error
+	FetchWaveformMeasurement(fcn scope.MeasFunction) (float64, error)
+	ReadWaveformMeasurement(fcn scope.MeasFunction, maxTime time.Duration) (float64, error)
+}
+
+// ScopeVertical configures the input of an oscilloscope channel. It isn't
+// part of ScopeChannel since remote channels don't provide it, but the
+// channels of the infiniivision driver implement it.
+type ScopeVertical interface {
+	SetInputImpedance(ohms float64) error
+	Configure(
+		rng, offset float64,
+		coupling scope.VerticalCoupling,
+		autoProbe bool,
+		probeAttenuation float64,
+		enabled bool,
+	) error
+}
+
+// ScopeTiming configures the acquisition and trigger of an oscilloscope. The
+// infiniivision driver implements it; use As to get it from a Scope.
+type ScopeTiming interface {
+	SetAcquisitionTimePerRecord(d time.Duration) error
+	SetAcquisitionStartTime(d time.Duration) error
+	SetTriggerType(t scope.TriggerType) error
+	SetTriggerLevel(v float64) error
+	SetTriggerHoldoff(d time.Duration) error
+}
+
+// Swtch is a switch matrix. Channels are identified by their names or the
+// virtual names given to them.
+type Swtch interface {
+	Inherent
+	ChannelCount() int
+	Connect(ch1, ch2 string) error
+	Disconnect(ch1, ch2 string) error
+	DisconnectAll() error
+}
+
+// The drivers return their own concrete channel types, so the adapters below
+// turn a driver's Channel method into one returning the class interface.
+// Unwrap returns the driver itself for code, such as the SCPI terminal, that
+// needs the methods outside the class interface.
+
+type dcpwrDriver[C DCPwrChannel] interface {
+	Inherent
+	OutputChannelCount() int
+	Channel(i int) (C, error)
+}
+
+type dcpwrAdapter[C DCPwrChannel] struct {
+	dcpwrDriver[C]
+}
+
+// newDCPwr infers the driver's channel type from its Channel method.
+func newDCPwr[C DCPwrChannel](d dcpwrDriver[C]) DCPwr { return dcpwrAdapter[C]{d} }
+
+func (a dcpwrAdapter[C]) Unwrap() Inherent { return a.dcpwrDriver }
+
+func (a dcpwrAdapter[C]) Channel(i int) (DCPwrChannel, error) {
+	ch, err := a.dcpwrDriver.Channel(i)
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+type fgenDriver[C FGenChannel] interface {
+	Inherent
+	ChannelCount() int
+	Channel(i int) (C, error)
+}
+
+type fgenAdapter[C FGenChannel] struct {
+	fgenDriver[C]
+}
+
+func newFGen[C FGenChannel](d fgenDriver[C]) FGen { return fgenAdapter[C]{d} }
+
+func (a fgenAdapter[C]) Unwrap() Inherent { return a.fgenDriver }
+
+func (a fgenAdapter[C]) Channel(i int) (FGenChannel, error) {
+	ch, err := a.fgenDriver.Channel(i)
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+type scopeDriver[C ScopeChannel] interface {
+	Inherent
+	ChannelCount() int
+	Channel(i int) (C, error)
+}
+
+type scopeAdapter[C ScopeChannel] struct {
+	scopeDriver[C]
+}
+
+func newScope[C ScopeChannel](d scopeDriver[C]) Scope { return scopeAdapter[C]{d} }
+
+func (a scopeAdapter[C]) Unwrap() Inherent { return a.scopeDriver }
+
+func (a scopeAdapter[C]) Channel(i int) (ScopeChannel, error) {
+	ch, err := a.scopeDriver.Channel(i)
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// As returns drv as a T, looking through the adapters and wrappers that
+// provide Unwrap for driver methods outside the class interfaces.
+func As[T any](drv Inherent) (T, bool) {
+	for {
+		if t, ok := drv.(T); ok {
+			return t, true
+		}
+		u, ok := drv.(interface{ Unwrap() Inherent })
+		if !ok {
+			var zero T
+			return zero, false
+		}
+		drv = u.Unwrap()
+	}
+}
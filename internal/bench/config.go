@@ -0,0 +1,113 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bench
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config lists the instruments on a bench.
+type Config struct {
+	Instruments []InstrumentConfig `json:"instruments"`
+}
+
+// InstrumentConfig describes how to open one instrument. Exactly one of
+// Address or Prologix must be set.
+type InstrumentConfig struct {
+	// Name identifies the instrument, e.g., in HTTP paths. Must be unique.
+	Name string `json:"name"`
+	// Driver is the IVI driver to use, e.g., "e36000" or "kt34400". See
+	// Drivers for the supported names.
+	Driver string `json:"driver"`
+	// Address is the VISA address of an LXI, USBTMC, or serial instrument.
+	Address string `json:"address,omitempty"`
+	// Prologix reaches the instrument through a Prologix GPIB controller.
+	Prologix *PrologixConfig `json:"prologix,omitempty"`
+	// Reset resets the instrument when it's opened.
+	Reset bool `json:"reset,omitempty"`
+	// SCPILock also takes the instrument's own lock with SYSTem:LOCK, which
+	// Keysight LXI instruments provide, for as long as it's open.
+	SCPILock bool `json:"scpi_lock,omitempty"`
+	// Timeout bounds each driver operation. The default is 5 s.
+	Timeout Duration `json:"timeout,omitzero"`
+}
+
+// PrologixConfig identifies an instrument behind a Prologix VCP GPIB
+// controller.
+type PrologixConfig struct {
+	Port string `json:"port"` // serial port of the controller
+	GPIB int    `json:"gpib"` // primary GPIB address of the instrument
+}
+
+// Duration is a time.Duration written in JSON as a string such as "5s".
+type Duration struct {
+	time.Duration
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"5s\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+// LoadConfig reads and validates a JSON bench configuration file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if err = cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that instrument names are unique, that every driver is
+// known, and that each instrument has exactly one address.
+func (c Config) Validate() error {
+	var errs []error
+	seen := make(map[string]bool)
+	for i, inst := range c.Instruments {
+		switch {
+		case inst.Name == "":
+			errs = append(errs, fmt.Errorf("instrument %d: missing name", i))
+		case seen[inst.Name]:
+			errs = append(errs, fmt.Errorf("instrument %q: duplicate name", inst.Name))
+		}
+		seen[inst.Name] = true
+		if _, ok := drivers[inst.Driver]; !ok {
+			errs = append(errs, fmt.Errorf("instrument %q: unknown driver %q", inst.Name, inst.Driver))
+		}
+		if (inst.Address == "") == (inst.Prologix == nil) {
+			errs = append(errs, fmt.Errorf("instrument %q: set exactly one of address or prologix", inst.Name))
+		}
+		if inst.Timeout.Duration < 0 {
+			errs = append(errs, fmt.Errorf("instrument %q: negative timeout", inst.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
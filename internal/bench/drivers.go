@@ -0,0 +1,112 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package bench
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi/dcpwr/keysight/e36000"
+	"github.com/gotmc/ivi/dcpwr/kikusui/pmx"
+	"github.com/gotmc/ivi/dmm/fluke/fluke45"
+	"github.com/gotmc/ivi/dmm/keysight/kt34400"
+	"github.com/gotmc/ivi/fgen/keysight/kt33000"
+	"github.com/gotmc/ivi/fgen/srs/ds345"
+	"github.com/gotmc/ivi/scope/keysight/infiniivision"
+	"github.com/gotmc/ivi/swtch/keysight/u2751a"
+)
+
+// driver creates the class interface for one IVI driver.
+type driver struct {
+	class Class
+	open  func(t ivi.Transport, opts ...ivi.Option) (Inherent, error)
+}
+
+// drivers maps the driver names used in a Config to the IVI drivers used by
+// the examples in this repository.
+var drivers = map[string]driver{
+	"e36000": {ClassDCPwr, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		d, err := e36000.New(t, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newDCPwr(d), nil
+	}},
+	"pmx": {ClassDCPwr, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		d, err := pmx.New(t, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newDCPwr(d), nil
+	}},
+	"kt34400": {ClassDMM, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		return kt34400.New(t, opts...)
+	}},
+	"fluke45": {ClassDMM, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		return fluke45.New(t, opts...)
+	}},
+	"kt33000": {ClassFGen, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		d, err := kt33000.New(t, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newFGen(d), nil
+	}},
+	"ds345": {ClassFGen, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		d, err := ds345.New(t, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newFGen(d), nil
+	}},
+	"infiniivision": {ClassScope, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		d, err := infiniivision.New(t, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return newScope(d), nil
+	}},
+	"u2751a": {ClassSwtch, func(t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+		return u2751a.New(t, opts...)
+	}},
+}
+
+// Drivers returns the sorted names of the supported drivers.
+func Drivers() []string {
+	names := make([]string, 0, len(drivers))
+	for name := range drivers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NewDriver creates the named driver on the transport, returning the class
+// interface of the driver.
+func NewDriver(name string, t ivi.Transport, opts ...ivi.Option) (Inherent, error) {
+	d, ok := drivers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown driver %q (want one of %s)", name, strings.Join(Drivers(), ", "))
+	}
+	return d.open(t, opts...)
+}
+
+// ParseEnum looks up an IVI enum value, such as a dmm.MeasurementFunction, by
+// the name its String method returns, ignoring case.
+func ParseEnum[T interface {
+	~int
+	fmt.Stringer
+}](name string) (T, error) {
+	for i := range 64 {
+		if v := T(i); strings.EqualFold(v.String(), name) {
+			return v, nil
+		}
+	}
+	var zero T
+	return zero, fmt.Errorf("unknown %T %q", zero, name)
+}
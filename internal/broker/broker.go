@@ -0,0 +1,107 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package broker shares instrument connections between processes. A broker
+// daemon owns the connections, such as USBTMC devices, serial ports, and
+// Prologix controllers, which are slow to open and can only be opened by one
+// process at a time, and serves them over a Unix socket. A Client is an
+// ivi.Transport, so the IVI drivers work through it unchanged, and many
+// short-lived processes can share one physical connection without reopening
+// it.
+//
+// Each client connection is a session on one device. A session is given the
+// device for each request and keeps it until it has been idle for the hold
+// time, so that a command followed by a read, or a query split over several
+// reads, isn't interleaved with the requests of other sessions. Call Release
+// to hand the device over sooner.
+//
+// The protocol is one JSON request per line answered by one JSON response.
+package broker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SocketEnv is the environment variable that, when set to the socket of a
+// running broker, makes bench.OpenTransport connect through the broker.
+const SocketEnv = "IVI_BROKER"
+
+// DefaultSocket returns the default socket path, ivi-broker.sock in the
+// temporary directory.
+func DefaultSocket() string { return filepath.Join(os.TempDir(), "ivi-broker.sock") }
+
+// Device identifies a connection: either a VISA address or the GPIB address
+// of an instrument behind a Prologix controller on a serial port.
+type Device struct {
+	Address string `json:"address,omitempty"`
+	Port    string `json:"port,omitempty"`
+	GPIB    int    `json:"gpib,omitempty"`
+}
+
+func (d Device) String() string {
+	if d.Port != "" {
+		return fmt.Sprintf("%s GPIB %d", d.Port, d.GPIB)
+	}
+	return d.Address
+}
+
+// key identifies the device's connection. VISA addresses differing only in
+// case are the same device.
+func (d Device) key() string {
+	if d.Port != "" {
+		return d.String()
+	}
+	return strings.ToUpper(strings.TrimSpace(d.Address))
+}
+
+// DeviceStatus describes an open device.
+type DeviceStatus struct {
+	Device   string    `json:"device"`
+	Opened   time.Time `json:"opened"`
+	Sessions int       `json:"sessions"`
+	Held     bool      `json:"held"` // a session has the device
+	Requests int       `json:"requests"`
+}
+
+// Request operations.
+const (
+	opOpen    = "open"
+	opCommand = "command"
+	opQuery   = "query"
+	opRead    = "read"
+	opWrite   = "write"
+	opRelease = "release"
+	opStatus  = "status"
+)
+
+// maxWrite bounds the bytes of data or command text in a request. An
+// arbitrary waveform of a million points sent as SCPI text is about 6 MB.
+const maxWrite = 16 << 20
+
+// maxLine bounds a line of the protocol: a request with maxWrite bytes of
+// base64 data or a response with maxRead bytes, plus the other fields.
+const maxLine = (maxWrite+2)/3*4 + 64<<10
+
+type request struct {
+	Op      string        `json:"op"`
+	Device  *Device       `json:"device,omitempty"`
+	Cmd     string        `json:"cmd,omitempty"`
+	Data    []byte        `json:"data,omitempty"`
+	N       int           `json:"n,omitempty"`
+	Timeout time.Duration `json:"timeout,omitempty"` // zero = the broker's default
+}
+
+type response struct {
+	Resp     string         `json:"resp,omitempty"`
+	Data     []byte         `json:"data,omitempty"`
+	N        int            `json:"n,omitempty"`
+	Err      string         `json:"err,omitempty"`
+	Deadline bool           `json:"deadline,omitempty"` // Err is a timeout
+	Devices  []DeviceStatus `json:"devices,omitempty"`
+}
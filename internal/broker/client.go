@@ -0,0 +1,177 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package broker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// replyGrace is how much longer than a request's timeout the client waits
+// for the broker's response.
+const replyGrace = 2 * time.Second
+
+// Client is a session on a device served by a broker. It implements
+// ivi.Transport. Each request is bounded by its context's deadline, or by the
+// broker's DefaultTimeout if it has none.
+type Client struct {
+	device string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	sc     *bufio.Scanner
+	enc    *json.Encoder
+	broken error // set once the connection is out of step with the broker
+}
+
+// Dial starts a session on the device with the broker listening on socket,
+// which opens the device if no other session has it open.
+func Dial(ctx context.Context, socket string, dev Device) (*Client, error) {
+	c, err := dial(ctx, socket)
+	if err != nil {
+		return nil, err
+	}
+	c.device = dev.String()
+	if _, err := c.do(ctx, request{Op: opOpen, Device: &dev}); err != nil {
+		return nil, errors.Join(err, c.Close())
+	}
+	return c, nil
+}
+
+func dial(ctx context.Context, socket string) (*Client, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to broker: %w", err)
+	}
+	sc := bufio.NewScanner(conn)
+	sc.Buffer(nil, maxLine)
+	return &Client{device: socket, conn: conn, sc: sc, enc: json.NewEncoder(conn)}, nil
+}
+
+// Status returns the devices open in the broker listening on socket.
+func Status(ctx context.Context, socket string) ([]DeviceStatus, error) {
+	c, err := dial(ctx, socket)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, request{Op: opStatus})
+	return resp.Devices, errors.Join(err, c.Close())
+}
+
+// do sends a request and waits for its response. If ctx is cancelled or the
+// broker doesn't answer in time, the session ends, since the response would
+// otherwise be read by the next request.
+func (c *Client) do(ctx context.Context, req request) (response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.broken != nil {
+		return response{}, c.broken
+	}
+	// The broker would end the session on a line longer than it reads.
+	if n := max(len(req.Cmd), len(req.Data)); n > maxWrite {
+		return response{}, fmt.Errorf("broker: %s of %d bytes exceeds the %d byte limit", req.Op, n, maxWrite)
+	}
+	deadline := time.Now().Add(DefaultTimeout)
+	if dl, ok := ctx.Deadline(); ok {
+		req.Timeout = time.Until(dl)
+		if req.Timeout <= 0 {
+			return response{}, context.DeadlineExceeded
+		}
+		deadline = dl
+	}
+	if err := c.conn.SetDeadline(deadline.Add(replyGrace)); err != nil {
+		return response{}, err
+	}
+	// The broker answers a request that times out, so only stop waiting early
+	// if ctx is cancelled.
+	stop := context.AfterFunc(ctx, func() {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			c.conn.SetDeadline(time.Now())
+		}
+	})
+	defer stop()
+
+	err := c.enc.Encode(req)
+	if err == nil && !c.sc.Scan() {
+		err = c.sc.Err()
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	var resp response
+	if err == nil {
+		err = json.Unmarshal(c.sc.Bytes(), &resp)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		c.broken = fmt.Errorf("broker session on %s ended: %w", c.device, err)
+		c.conn.Close()
+		return response{}, c.broken
+	}
+	if resp.Err != "" {
+		if resp.Deadline {
+			return resp, fmt.Errorf("%w: %s", context.DeadlineExceeded, resp.Err)
+		}
+		return resp, errors.New(resp.Err)
+	}
+	return resp, nil
+}
+
+// Command sends a command, formatted with fmt.Sprintf if arguments are given.
+func (c *Client) Command(ctx context.Context, cmd string, a ...any) error {
+	if len(a) > 0 {
+		cmd = fmt.Sprintf(cmd, a...)
+	}
+	_, err := c.do(ctx, request{Op: opCommand, Cmd: cmd})
+	return err
+}
+
+// Query sends a query and returns the response.
+func (c *Client) Query(ctx context.Context, cmd string) (string, error) {
+	resp, err := c.do(ctx, request{Op: opQuery, Cmd: cmd})
+	return resp.Resp, err
+}
+
+// ReadBinary reads up to len(p) bytes from the device.
+func (c *Client) ReadBinary(ctx context.Context, p []byte) (int, error) {
+	resp, err := c.do(ctx, request{Op: opRead, N: len(p)})
+	return copy(p, resp.Data), err
+}
+
+// WriteBinary writes p to the device.
+func (c *Client) WriteBinary(ctx context.Context, p []byte) (int, error) {
+	resp, err := c.do(ctx, request{Op: opWrite, Data: p})
+	return resp.N, err
+}
+
+// Release lets other sessions use the device now rather than after the hold
+// time.
+func (c *Client) Release(ctx context.Context) error {
+	_, err := c.do(ctx, request{Op: opRelease})
+	return err
+}
+
+// Close ends the session. The broker keeps the device open for other and
+// later sessions.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.broken != nil {
+		return nil
+	}
+	c.broken = net.ErrClosed
+	return c.conn.Close()
+}
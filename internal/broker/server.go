@@ -0,0 +1,415 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package broker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi"
+)
+
+// DefaultHold is how long a session keeps its device after a request.
+const DefaultHold = 250 * time.Millisecond
+
+// DefaultIdle is how long a device without sessions stays open.
+const DefaultIdle = 5 * time.Minute
+
+// DefaultTimeout bounds a request that doesn't set a timeout, including the
+// wait for other sessions to release the device.
+const DefaultTimeout = 10 * time.Second
+
+// maxRead bounds the bytes read by one read request.
+const maxRead = 1 << 20
+
+// Opener opens the connection to a device.
+type Opener func(ctx context.Context, dev Device) (ivi.Transport, error)
+
+// Server owns the device connections and serves client sessions.
+type Server struct {
+	open   Opener
+	hold   time.Duration
+	idle   time.Duration
+	logger *log.Logger
+
+	mu      sync.Mutex
+	devices map[string]*device
+	conns   map[net.Conn]bool
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithHold sets how long a session keeps its device after each request. The
+// default is DefaultHold.
+func WithHold(d time.Duration) Option { return func(s *Server) { s.hold = d } }
+
+// WithIdle sets how long a device stays open after its last session ends.
+// Zero keeps devices open until the server stops. The default is
+// DefaultIdle.
+func WithIdle(d time.Duration) Option { return func(s *Server) { s.idle = d } }
+
+// WithLogger sets the logger for sessions and devices. The default is the
+// standard logger.
+func WithLogger(l *log.Logger) Option { return func(s *Server) { s.logger = l } }
+
+// NewServer creates a server that opens devices with open.
+func NewServer(open Opener, opts ...Option) *Server {
+	s := Server{
+		open:    open,
+		hold:    DefaultHold,
+		idle:    DefaultIdle,
+		logger:  log.Default(),
+		devices: make(map[string]*device),
+		conns:   make(map[net.Conn]bool),
+	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return &s
+}
+
+// Serve accepts sessions on l until ctx is done, then ends the sessions,
+// closes the devices, and returns the errors closing them.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { l.Close() })
+	defer stop()
+	var wg sync.WaitGroup
+	var err error
+	for {
+		conn, aerr := l.Accept()
+		if aerr != nil {
+			if ctx.Err() == nil {
+				err = aerr
+			}
+			break
+		}
+		s.mu.Lock()
+		s.conns[conn] = true
+		s.mu.Unlock()
+		wg.Go(func() {
+			s.serveConn(ctx, conn)
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+		})
+	}
+
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	wg.Wait()
+	return errors.Join(err, s.closeDevices())
+}
+
+// Status returns the open devices sorted by name.
+func (s *Server) Status() []DeviceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var st []DeviceStatus
+	for _, d := range s.devices {
+		st = append(st, d.status())
+	}
+	slices.SortFunc(st, func(a, b DeviceStatus) int { return strings.Compare(a.Device, b.Device) })
+	return st
+}
+
+func (s *Server) closeDevices() error {
+	s.mu.Lock()
+	devs := s.devices
+	s.devices = make(map[string]*device)
+	s.mu.Unlock()
+	var errs []error
+	for _, d := range devs {
+		<-d.ready
+		if d.err == nil {
+			errs = append(errs, d.close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// session is one client connection, which is a session on dev once opened.
+type session struct {
+	dev *device
+}
+
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	var (
+		ss  session
+		sc  = bufio.NewScanner(conn)
+		enc = json.NewEncoder(conn)
+	)
+	sc.Buffer(nil, maxLine)
+	defer func() {
+		if ss.dev != nil {
+			ss.dev.release(&ss)
+			s.detach(ss.dev)
+		}
+	}()
+	for sc.Scan() {
+		var req request
+		var resp response
+		if err := json.Unmarshal(sc.Bytes(), &req); err != nil {
+			resp.Err = fmt.Sprintf("invalid request: %s", err)
+		} else {
+			resp = s.handle(ctx, &ss, req)
+		}
+		if err := enc.Encode(resp); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, ss *session, req request) response {
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch req.Op {
+	case opStatus:
+		return response{Devices: s.Status()}
+	case opOpen:
+		if ss.dev != nil {
+			return response{Err: "session already open"}
+		}
+		if req.Device == nil {
+			return response{Err: "missing device"}
+		}
+		d, err := s.attach(ctx, *req.Device)
+		if err != nil {
+			return errResponse(err)
+		}
+		ss.dev = d
+		return response{}
+	}
+	if ss.dev == nil {
+		return response{Err: "session isn't open"}
+	}
+	if req.Op == opRelease {
+		ss.dev.release(ss)
+		return response{}
+	}
+
+	if !slices.Contains([]string{opCommand, opQuery, opRead, opWrite}, req.Op) {
+		return response{Err: fmt.Sprintf("unknown op %q", req.Op)}
+	}
+	if req.Op == opRead {
+		switch {
+		case req.N < 0:
+			return response{Err: fmt.Sprintf("invalid read length %d", req.N)}
+		case req.N == 0:
+			return response{}
+		}
+	}
+	d := ss.dev
+	if err := d.acquire(ctx, ss); err != nil {
+		return errResponse(fmt.Errorf("waiting for %s: %w", d.name, err))
+	}
+	defer d.done(ss, s.hold)
+	var resp response
+	var err error
+	switch req.Op {
+	case opCommand:
+		err = d.t.Command(ctx, req.Cmd)
+	case opQuery:
+		resp.Resp, err = d.t.Query(ctx, req.Cmd)
+	case opRead:
+		buf := make([]byte, min(req.N, maxRead))
+		resp.N, err = d.t.ReadBinary(ctx, buf)
+		resp.Data = buf[:resp.N]
+	case opWrite:
+		resp.N, err = d.t.WriteBinary(ctx, req.Data)
+	}
+	if err != nil {
+		e := errResponse(err)
+		e.Resp, e.Data, e.N = resp.Resp, resp.Data, resp.N
+		return e
+	}
+	return resp
+}
+
+func errResponse(err error) response {
+	return response{Err: err.Error(), Deadline: errors.Is(err, context.DeadlineExceeded)}
+}
+
+// attach returns the open device, opening it if this is its first session.
+func (s *Server) attach(ctx context.Context, dev Device) (*device, error) {
+	key := dev.key()
+	s.mu.Lock()
+	d, ok := s.devices[key]
+	if !ok {
+		d = &device{
+			key:    key,
+			name:   dev.String(),
+			opened: time.Now(),
+			sem:    make(chan struct{}, 1),
+			ready:  make(chan struct{}),
+		}
+		s.devices[key] = d
+	}
+	d.sessions++
+	if d.idleTimer != nil {
+		d.idleTimer.Stop()
+		d.idleTimer = nil
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		d.t, d.err = s.open(ctx, dev)
+		if d.err != nil {
+			s.mu.Lock()
+			delete(s.devices, key)
+			s.mu.Unlock()
+		} else {
+			s.logger.Printf("Opened %s", d.name)
+		}
+		close(d.ready)
+	}
+	select {
+	case <-d.ready:
+	case <-ctx.Done():
+		s.detach(d)
+		return nil, ctx.Err()
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", d.name, d.err)
+	}
+	return d, nil
+}
+
+// detach ends a session on d, closing d once it has had no sessions for the
+// idle time.
+func (s *Server) detach(d *device) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d.sessions--
+	if d.sessions > 0 || s.idle <= 0 {
+		return
+	}
+	d.idleTimer = time.AfterFunc(s.idle, func() {
+		s.mu.Lock()
+		if d.sessions > 0 || s.devices[d.key] != d {
+			s.mu.Unlock()
+			return
+		}
+		delete(s.devices, d.key)
+		s.mu.Unlock()
+		<-d.ready
+		if d.err != nil {
+			return
+		}
+		if err := d.close(); err != nil {
+			s.logger.Printf("error closing %s: %s", d.name, err)
+			return
+		}
+		s.logger.Printf("Closed idle %s", d.name)
+	})
+}
+
+// device is an open connection shared by sessions. The session in owner has
+// the device; sem is full while any session has it.
+type device struct {
+	key   string
+	name  string
+	t     ivi.Transport
+	err   error         // error opening the device
+	ready chan struct{} // closed once opened
+	sem   chan struct{}
+
+	// Guarded by the server's mu.
+	opened    time.Time
+	sessions  int
+	idleTimer *time.Timer
+
+	mu       sync.Mutex
+	owner    *session
+	gen      int // invalidates pending hold timers
+	requests int
+}
+
+// acquire gives the device to ss, waiting for another session to release it.
+func (d *device) acquire(ctx context.Context, ss *session) error {
+	d.mu.Lock()
+	if d.owner == ss {
+		d.gen++
+		d.requests++
+		d.mu.Unlock()
+		return nil
+	}
+	d.mu.Unlock()
+	select {
+	case d.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	d.mu.Lock()
+	d.owner = ss
+	d.gen++
+	d.requests++
+	d.mu.Unlock()
+	return nil
+}
+
+// done releases the device after the hold time unless ss makes another
+// request first.
+func (d *device) done(ss *session, hold time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.gen++
+	gen := d.gen
+	time.AfterFunc(hold, func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if d.owner == ss && d.gen == gen {
+			d.owner = nil
+			<-d.sem
+		}
+	})
+}
+
+// release releases the device now if ss has it.
+func (d *device) release(ss *session) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.owner == ss {
+		d.owner = nil
+		d.gen++
+		<-d.sem
+	}
+}
+
+// status returns the device's status. The caller must hold the server's mu.
+func (d *device) status() DeviceStatus {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return DeviceStatus{
+		Device:   d.name,
+		Opened:   d.opened,
+		Sessions: d.sessions,
+		Held:     d.owner != nil,
+		Requests: d.requests,
+	}
+}
+
+func (d *device) close() error {
+	return d.t.Close()
+}
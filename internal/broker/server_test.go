@@ -0,0 +1,116 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package broker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/gotmc/ivi"
+)
+
+// fakeTransport answers every query with "ok" and fills every read.
+type fakeTransport struct{}
+
+func (fakeTransport) Command(ctx context.Context, cmd string, a ...any) error { return nil }
+func (fakeTransport) Query(ctx context.Context, cmd string) (string, error)   { return "ok", nil }
+func (fakeTransport) WriteBinary(ctx context.Context, p []byte) (int, error)  { return len(p), nil }
+func (fakeTransport) Close() error                                            { return nil }
+
+func (fakeTransport) ReadBinary(ctx context.Context, p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'x'
+	}
+	return len(p), nil
+}
+
+// serve starts a server with fake devices on a socket in a temporary
+// directory and returns the socket.
+func serve(t *testing.T) string {
+	t.Helper()
+	socket := filepath.Join(t.TempDir(), "broker.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := func(ctx context.Context, dev Device) (ivi.Transport, error) { return fakeTransport{}, nil }
+	s := NewServer(open, WithLogger(log.New(io.Discard, "", 0)))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Serve(ctx, l) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Serve: %s", err)
+		}
+	})
+	return socket
+}
+
+// rawSession sends requests written as JSON and returns the responses.
+type rawSession struct {
+	t    *testing.T
+	conn net.Conn
+	sc   *bufio.Scanner
+}
+
+func newRawSession(t *testing.T, socket string) *rawSession {
+	t.Helper()
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &rawSession{t: t, conn: conn, sc: bufio.NewScanner(conn)}
+}
+
+func (rs *rawSession) do(req string) response {
+	rs.t.Helper()
+	if _, err := io.WriteString(rs.conn, req+"\n"); err != nil {
+		rs.t.Fatal(err)
+	}
+	if !rs.sc.Scan() {
+		rs.t.Fatalf("no response to %s: %v", req, rs.sc.Err())
+	}
+	var resp response
+	if err := json.Unmarshal(rs.sc.Bytes(), &resp); err != nil {
+		rs.t.Fatal(err)
+	}
+	return resp
+}
+
+func TestServerReadLength(t *testing.T) {
+	socket := serve(t)
+	rs := newRawSession(t, socket)
+	if resp := rs.do(`{"op":"open","device":{"address":"TCPIP0::fake::INSTR"}}`); resp.Err != "" {
+		t.Fatalf("open: %s", resp.Err)
+	}
+
+	if resp := rs.do(`{"op":"read","n":-1}`); resp.Err == "" {
+		t.Errorf("read of -1 bytes succeeded with %+v", resp)
+	}
+	if resp := rs.do(`{"op":"read","n":0}`); resp.Err != "" || len(resp.Data) != 0 {
+		t.Errorf("read of 0 bytes = %+v, want an empty read", resp)
+	}
+	if resp := rs.do(`{"op":"read","n":3}`); resp.Err != "" || string(resp.Data) != "xxx" {
+		t.Errorf("read of 3 bytes = %+v, want xxx", resp)
+	}
+
+	// The rejected read mustn't have taken down the broker for others.
+	other := newRawSession(t, socket)
+	if resp := other.do(`{"op":"open","device":{"address":"TCPIP0::fake::INSTR"}}`); resp.Err != "" {
+		t.Fatalf("open of a second session: %s", resp.Err)
+	}
+	if resp := other.do(`{"op":"query","cmd":"*IDN?"}`); resp.Err != "" || resp.Resp != "ok" {
+		t.Errorf("query of a second session = %+v, want ok", resp)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package errqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// StepError reports the instrument errors queued while running a named
+// configuration step.
+type StepError struct {
+	Step    string
+	Entries []Entry
+}
+
+// Error implements the error interface.
+func (e *StepError) Error() string {
+	if len(e.Entries) == 1 {
+		return fmt.Sprintf("%s: %s", e.Step, e.Entries[0])
+	}
+	return fmt.Sprintf("%s: %d instrument errors, first: %s", e.Step, len(e.Entries), e.Entries[0])
+}
+
+// Unwrap returns the individual entries so errors.As can match an Entry.
+func (e *StepError) Unwrap() []error {
+	errs := make([]error, len(e.Entries))
+	for i, entry := range e.Entries {
+		errs[i] = entry
+	}
+	return errs
+}
+
+// Checker drains an instrument's errors after each configuration step. By
+// default the errors are logged; with WithStrict they also fail the step.
+type Checker struct {
+	q       Querier
+	dialect Dialect
+	strict  bool
+	timeout time.Duration
+	logger  *log.Logger
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// WithStrict makes Step return a *StepError when the instrument queued errors
+// even though the step itself succeeded.
+func WithStrict() Option { return func(c *Checker) { c.strict = true } }
+
+// WithTimeout bounds each drain of the error queue.
+func WithTimeout(d time.Duration) Option { return func(c *Checker) { c.timeout = d } }
+
+// WithLogger sets the logger used to report instrument errors. The default is
+// the standard logger.
+func WithLogger(l *log.Logger) Option { return func(c *Checker) { c.logger = l } }
+
+// NewChecker creates a Checker reading the errors of the instrument behind q
+// using the given dialect.
+func NewChecker(q Querier, d Dialect, opts ...Option) *Checker {
+	c := Checker{
+		q:       q,
+		dialect: d,
+		timeout: 5 * time.Second,
+		logger:  log.Default(),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// Step runs fn and then drains the instrument's errors. An error from fn is
+// returned as is. Any queued instrument errors are logged and, if the Checker
+// is strict, returned as a *StepError.
+func (c *Checker) Step(ctx context.Context, name string, fn func() error) error {
+	if err := fn(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	dctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	entries, err := Drain(dctx, c.q, c.dialect)
+	for _, entry := range entries {
+		c.logger.Printf("%s: %s", name, entry)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if c.strict && len(entries) > 0 {
+		return &StepError{Step: name, Entries: entries}
+	}
+	return nil
+}
+
+// Discard drains and logs any errors already queued, such as those left over
+// from a previous session, so they aren't blamed on the next step.
+func (c *Checker) Discard(ctx context.Context) error {
+	dctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	entries, err := Drain(dctx, c.q, c.dialect)
+	for _, entry := range entries {
+		c.logger.Printf("discarding stale %s", entry)
+	}
+	return err
+}
+
+// Is reports whether err contains an instrument Entry with the given code.
+func Is(err error, code int) bool {
+	for err != nil {
+		switch e := err.(type) {
+		case Entry:
+			if e.Code == code {
+				return true
+			}
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				if Is(inner, code) {
+					return true
+				}
+			}
+			return false
+		}
+		err = errors.Unwrap(err)
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package errqueue reads the errors an instrument has queued up, either from
+// the SCPI SYST:ERR? queue or from the status registers of older instruments
+// such as the SRS DS345 and Fluke 45, and turns them into Go errors.
+//
+// IVI driver methods only report transport-level failures, so a method can
+// return nil while the instrument rejected or adjusted the setting. Draining
+// the error queue after each configuration step surfaces those problems.
+package errqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Querier is the part of an ivi.Transport needed to read the error queue.
+type Querier interface {
+	Query(ctx context.Context, cmd string) (string, error)
+}
+
+// SCPI error codes worth checking for by callers.
+const (
+	CommandError     = -100
+	ExecutionError   = -200
+	SettingsConflict = -221
+	DataOutOfRange   = -222
+	DeviceError      = -300
+	QueueOverflow    = -350
+	QueryError       = -400
+)
+
+// Entry is one error reported by the instrument. Codes follow the SCPI
+// convention of negative standard codes and positive device-specific codes.
+type Entry struct {
+	Code    int
+	Message string
+}
+
+// Error implements the error interface.
+func (e Entry) Error() string {
+	return fmt.Sprintf("instrument error %d: %s", e.Code, e.Message)
+}
+
+// Dialect identifies how an instrument reports its errors.
+type Dialect int
+
+// Available dialects.
+const (
+	SCPI    Dialect = iota // SYST:ERR? error queue
+	DS345                  // *ESR? and STAT? status registers
+	Fluke45                // *ESR? status register
+)
+
+// String implements the Stringer interface for Dialect.
+func (d Dialect) String() string {
+	switch d {
+	case SCPI:
+		return "SCPI"
+	case DS345:
+		return "DS345"
+	case Fluke45:
+		return "Fluke 45"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// maxEntries caps how many times SYST:ERR? is read in case an instrument never
+// reports an empty queue. Keysight instruments queue at most 20 errors.
+const maxEntries = 32
+
+// Drain reads and thereby clears all the errors the instrument has queued
+// using the given dialect. An empty slice means the instrument reported no
+// errors.
+func Drain(ctx context.Context, q Querier, d Dialect) ([]Entry, error) {
+	switch d {
+	case SCPI:
+		return drainSCPI(ctx, q)
+	case DS345:
+		entries, err := readESR(ctx, q)
+		if err != nil {
+			return entries, err
+		}
+		status, err := readStatus(ctx, q, "STAT?", ds345Status)
+		return append(entries, status...), err
+	case Fluke45:
+		return readESR(ctx, q)
+	}
+	return nil, fmt.Errorf("unknown error queue dialect %s", d)
+}
+
+// Check drains the instrument's errors and returns them joined into a single
+// error, or nil if the instrument reported none. Use errors.As to get at the
+// individual entries.
+func Check(ctx context.Context, q Querier, d Dialect) error {
+	entries, err := Drain(ctx, q, d)
+	errs := make([]error, 0, len(entries)+1)
+	for _, entry := range entries {
+		errs = append(errs, entry)
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func drainSCPI(ctx context.Context, q Querier) ([]Entry, error) {
+	var entries []Entry
+	for range maxEntries {
+		resp, err := q.Query(ctx, "SYST:ERR?")
+		if err != nil {
+			return entries, fmt.Errorf("error querying SYST:ERR?: %w", err)
+		}
+		entry, err := ParseSCPI(resp)
+		if err != nil {
+			return entries, err
+		}
+		if entry.Code == 0 {
+			return entries, nil
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ParseSCPI parses a SYST:ERR? response such as `-221,"Settings conflict"`.
+// A code of 0 means the queue is empty.
+func ParseSCPI(resp string) (Entry, error) {
+	code, msg, _ := strings.Cut(strings.TrimSpace(resp), ",")
+	n, err := strconv.Atoi(strings.TrimSpace(code))
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid SYST:ERR? response %q", resp)
+	}
+	return Entry{Code: n, Message: strings.Trim(strings.TrimSpace(msg), `"`)}, nil
+}
+
+// statusBit maps a set bit in a status register to an error entry.
+type statusBit struct {
+	bit   uint
+	entry Entry
+}
+
+// esrBits are the IEEE 488.2 standard event status register error bits, which
+// both the DS345 and the Fluke 45 implement, mapped to the SCPI error classes.
+var esrBits = []statusBit{
+	{2, Entry{QueryError, "query error"}},
+	{3, Entry{DeviceError, "device dependent error"}},
+	{4, Entry{ExecutionError, "execution error"}},
+	{5, Entry{CommandError, "command error"}},
+}
+
+// ds345Status are the error bits in the DS345's DDS status register.
+var ds345Status = []statusBit{
+	{1, Entry{DeviceError, "trigger rate too high"}},
+	{3, Entry{DeviceError, "external clock error"}},
+	{5, Entry{DeviceError, "self test error"}},
+	{6, Entry{DeviceError, "calibration error"}},
+}
+
+// readESR reads, and thereby clears, the standard event status register.
+func readESR(ctx context.Context, q Querier) ([]Entry, error) {
+	return readStatus(ctx, q, "*ESR?", esrBits)
+}
+
+func readStatus(ctx context.Context, q Querier, query string, bits []statusBit) ([]Entry, error) {
+	resp, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s: %w", query, err)
+	}
+	reg, err := strconv.ParseUint(strings.TrimSpace(resp), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s response %q", query, resp)
+	}
+	var entries []Entry
+	for _, b := range bits {
+		if reg&(1<<b.bit) != 0 {
+			entries = append(entries, b.entry)
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package gpibbus shares one Prologix GPIB-USB controller between the
+// instruments on its GPIB bus. A prologix.Controller talks to whichever
+// instrument it was last addressed to, so a Bus owns the controller and hands
+// out a Device for each GPIB address. Each Device is an ivi.Transport that
+// readdresses the controller with ++addr when another device used it last, and
+// the bus runs one transaction at a time, so the drivers of several
+// instruments can be used from different goroutines.
+//
+// Each call on a Device is one transaction. A Query can't be interleaved
+// with another device's, but a WriteBinary followed by a ReadBinary can, so
+// read responses with Query where the driver allows.
+package gpibbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/gotmc/prologix"
+	"github.com/gotmc/prologix/driver/vcp"
+)
+
+// ErrClosed is returned by a Device that is closed or whose Bus is closed.
+var ErrClosed = errors.New("gpibbus: closed")
+
+// Bus is a Prologix controller shared by the devices on its GPIB bus.
+type Bus struct {
+	c   *prologix.Controller
+	sem chan struct{} // full during a transaction
+
+	// Guarded by sem.
+	addr   int // address the controller is set to, or -1 if unknown
+	closed bool
+
+	mu      sync.Mutex
+	devices map[int]*Device
+}
+
+// Open opens the Prologix controller on a serial port (Virtual COM Port).
+func Open(port string) (*Bus, error) {
+	rw, err := vcp.NewVCP(port)
+	if err != nil {
+		return nil, err
+	}
+	b, err := New(rw)
+	if err != nil {
+		return nil, errors.Join(err, rw.Close())
+	}
+	return b, nil
+}
+
+// New configures the Prologix controller connected through rw. Closing the
+// bus closes rw if it's an io.Closer.
+func New(rw io.ReadWriter) (*Bus, error) {
+	// The controller needs an initial address, but each device sets its own
+	// before its first transaction.
+	c, err := prologix.NewController(rw, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	return &Bus{
+		c:       c,
+		sem:     make(chan struct{}, 1),
+		addr:    -1,
+		devices: make(map[int]*Device),
+	}, nil
+}
+
+// Device returns a handle on the instrument at a primary GPIB address. If
+// clear is true, the instrument is sent the Selected Device Clear message.
+// Only one handle per address can be open at a time.
+func (b *Bus) Device(ctx context.Context, addr int, clear bool) (*Device, error) {
+	if addr < 0 || addr > 30 {
+		return nil, fmt.Errorf("invalid GPIB address %d (must be 0-30)", addr)
+	}
+	b.mu.Lock()
+	if _, ok := b.devices[addr]; ok {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("GPIB address %d is already open", addr)
+	}
+	d := Device{bus: b, addr: addr}
+	b.devices[addr] = &d
+	b.mu.Unlock()
+
+	if clear {
+		err := d.do(ctx, func(c *prologix.Controller) error { return c.ClearDevice() })
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error clearing GPIB %d: %w", addr, err), d.Close())
+		}
+	}
+	return &d, nil
+}
+
+// Len returns the number of open devices.
+func (b *Bus) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.devices)
+}
+
+// Version returns the Prologix controller's version string.
+func (b *Bus) Version(ctx context.Context) (string, error) {
+	var v string
+	err := b.transact(ctx, -1, func(c *prologix.Controller) error {
+		var err error
+		v, err = c.Version()
+		return err
+	})
+	return v, err
+}
+
+// Close waits for the transaction in progress and closes the controller. The
+// devices still open fail with ErrClosed.
+func (b *Bus) Close() error {
+	b.sem <- struct{}{}
+	defer func() { <-b.sem }()
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	return b.c.Close()
+}
+
+// transact runs fn on the controller once no other transaction is in
+// progress, first addressing the controller to addr unless addr is negative.
+func (b *Bus) transact(ctx context.Context, addr int, fn func(c *prologix.Controller) error) error {
+	select {
+	case b.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-b.sem }()
+	if b.closed {
+		return ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if addr >= 0 && b.addr != addr {
+		if err := b.c.SetInstrumentAddress(addr); err != nil {
+			b.addr = -1
+			return fmt.Errorf("error addressing GPIB %d: %w", addr, err)
+		}
+		b.addr = addr
+	}
+	return fn(b.c)
+}
+
+// Device is the instrument at one GPIB address on a Bus. It implements
+// ivi.Transport.
+type Device struct {
+	bus  *Bus
+	addr int
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// Addr returns the device's primary GPIB address.
+func (d *Device) Addr() int { return d.addr }
+
+func (d *Device) do(ctx context.Context, fn func(c *prologix.Controller) error) error {
+	d.mu.Lock()
+	closed := d.closed
+	d.mu.Unlock()
+	if closed {
+		return ErrClosed
+	}
+	return d.bus.transact(ctx, d.addr, fn)
+}
+
+// Command sends a command, formatted with fmt.Sprintf if arguments are given.
+func (d *Device) Command(ctx context.Context, cmd string, a ...any) error {
+	return d.do(ctx, func(c *prologix.Controller) error { return c.Command(ctx, cmd, a...) })
+}
+
+// Query sends a query and reads the response.
+func (d *Device) Query(ctx context.Context, cmd string) (string, error) {
+	var s string
+	err := d.do(ctx, func(c *prologix.Controller) error {
+		var err error
+		s, err = c.Query(ctx, cmd)
+		return err
+	})
+	return s, err
+}
+
+// ReadBinary reads up to len(p) bytes from the device.
+func (d *Device) ReadBinary(ctx context.Context, p []byte) (int, error) {
+	var n int
+	err := d.do(ctx, func(c *prologix.Controller) error {
+		var err error
+		n, err = c.ReadBinary(ctx, p)
+		return err
+	})
+	return n, err
+}
+
+// WriteBinary writes p to the device.
+func (d *Device) WriteBinary(ctx context.Context, p []byte) (int, error) {
+	var n int
+	err := d.do(ctx, func(c *prologix.Controller) error {
+		var err error
+		n, err = c.WriteBinary(ctx, p)
+		return err
+	})
+	return n, err
+}
+
+// FrontPanel enables the instrument's front panel, returning it to local
+// control, or disables it with local lockout.
+func (d *Device) FrontPanel(ctx context.Context, enable bool) error {
+	return d.do(ctx, func(c *prologix.Controller) error { return c.FrontPanel(enable) })
+}
+
+// Close releases the GPIB address so it can be opened again. The bus stays
+// open.
+func (d *Device) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return nil
+	}
+	d.closed = true
+	d.bus.mu.Lock()
+	delete(d.bus.devices, d.addr)
+	d.bus.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package instlock keeps processes on the same computer from talking to an
+// instrument at the same time, which would interleave their commands. Each
+// instrument address has a lock file in a shared directory that's locked with
+// an advisory file lock (flock on Unix, LockFileEx on Windows) for as long as
+// the instrument is open. The holder writes its process ID, user, host,
+// command, and start time to the file so others can report who holds it. The
+// operating system releases the lock if the holder exits without releasing
+// it.
+//
+// Keysight LXI instruments also provide a lock of their own, shared by all
+// their I/O interfaces, through the SYSTem:LOCK commands; see RequestSCPI.
+package instlock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrLocked is returned when an instrument is locked by someone else.
+var ErrLocked = errors.New("instrument is locked")
+
+// DirEnv is the environment variable that overrides the lock directory.
+const DirEnv = "IVI_LOCK_DIR"
+
+// DefaultPoll is how often Acquire retries a held lock.
+const DefaultPoll = 200 * time.Millisecond
+
+// Dir returns the lock directory: $IVI_LOCK_DIR if set, or ivi-locks in the
+// temporary directory.
+func Dir() string {
+	if dir := os.Getenv(DirEnv); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.TempDir(), "ivi-locks")
+}
+
+// Holder describes the process holding a lock.
+type Holder struct {
+	Address string    `json:"address"`
+	PID     int       `json:"pid"`
+	User    string    `json:"user"`
+	Host    string    `json:"host"`
+	Command string    `json:"command"`
+	Since   time.Time `json:"since"`
+}
+
+func (h Holder) String() string {
+	return fmt.Sprintf("%s@%s (%s, pid %d) since %s",
+		h.User, h.Host, h.Command, h.PID, h.Since.Format(time.DateTime))
+}
+
+// LockedError is returned by Acquire when the lock is still held when the
+// context is done.
+type LockedError struct {
+	Address string
+	Holder  *Holder // nil if the holder didn't record itself
+}
+
+func (e *LockedError) Error() string {
+	if e.Holder == nil {
+		return fmt.Sprintf("%s is locked by another process", e.Address)
+	}
+	return fmt.Sprintf("%s is locked by %s", e.Address, e.Holder)
+}
+
+// Unwrap returns ErrLocked.
+func (e *LockedError) Unwrap() error { return ErrLocked }
+
+type options struct {
+	dir    string
+	poll   time.Duration
+	logger *log.Logger
+}
+
+// Option configures Acquire, Query, and List.
+type Option func(*options)
+
+// WithDir sets the lock directory. The default is Dir().
+func WithDir(dir string) Option { return func(o *options) { o.dir = dir } }
+
+// WithLogger sets the logger used to report waiting for a held lock. The
+// default is the standard logger.
+func WithLogger(l *log.Logger) Option { return func(o *options) { o.logger = l } }
+
+func newOptions(opts []Option) options {
+	o := options{dir: Dir(), poll: DefaultPoll, logger: log.Default()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Lock is a held instrument lock.
+type Lock struct {
+	Holder Holder
+
+	f        *os.File
+	writable bool
+}
+
+// Acquire locks the instrument at address, waiting for the current holder
+// to release it until ctx is done, in which case the error is a *LockedError.
+// VISA addresses differing only in case, or in the base of USB vendor and
+// product IDs, share a lock, as do serial addresses of the same port.
+func Acquire(ctx context.Context, address string, opts ...Option) (*Lock, error) {
+	o := newOptions(opts)
+	if err := os.MkdirAll(o.dir, 0o777); err != nil {
+		return nil, err
+	}
+	// Let every user share the directory and lock file; this fails harmlessly
+	// for those made by another user.
+	_ = os.Chmod(o.dir, 0o777|fs.ModeSticky)
+	path := filepath.Join(o.dir, fileName(address))
+	l := Lock{writable: true}
+	var err error
+	l.f, err = os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o666)
+	if errors.Is(err, fs.ErrPermission) {
+		l.f, err = os.Open(path)
+		l.writable = false
+	}
+	if err != nil {
+		return nil, err
+	}
+	_ = l.f.Chmod(0o666)
+
+	logged := false
+	for {
+		ok, err := tryLock(l.f)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error locking %s: %w", path, err), l.f.Close())
+		}
+		if ok {
+			break
+		}
+		h, _ := readHolder(l.f)
+		if !logged {
+			o.logger.Printf("%s; waiting", &LockedError{Address: address, Holder: h})
+			logged = true
+		}
+		select {
+		case <-time.After(o.poll):
+		case <-ctx.Done():
+			return nil, errors.Join(&LockedError{Address: address, Holder: h}, l.f.Close())
+		}
+	}
+
+	l.Holder = self(address)
+	if l.writable {
+		if err := l.write(&l.Holder); err != nil {
+			return nil, errors.Join(err, unlock(l.f), l.f.Close())
+		}
+	}
+	return &l, nil
+}
+
+// TimeoutFlag defines the -lock-timeout flag, how long a command waits for
+// another process to release an instrument's lock.
+func TimeoutFlag() *time.Duration {
+	return flag.Duration(
+		"lock-timeout",
+		time.Minute,
+		"How long to wait for another process to release the instrument lock",
+	)
+}
+
+// Hold is Acquire for a command: it waits at most timeout for the lock, and
+// returns a func for the command to defer that releases it, logging any error.
+func Hold(address string, timeout time.Duration, opts ...Option) (release func(), err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	l, err := Acquire(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	logger := newOptions(opts).logger
+	return func() {
+		if err := l.Release(); err != nil {
+			logger.Printf("error releasing instrument lock: %s", err)
+		}
+	}, nil
+}
+
+// Release clears the holder from the lock file and releases the lock. The
+// file is left in place, since removing it would let another process lock a
+// new file while a third still waits on the old one.
+func (l *Lock) Release() error {
+	var errs []error
+	if l.writable {
+		errs = append(errs, l.write(nil))
+	}
+	errs = append(errs, unlock(l.f), l.f.Close())
+	return errors.Join(errs...)
+}
+
+func (l *Lock) write(h *Holder) error {
+	if err := l.f.Truncate(0); err != nil {
+		return err
+	}
+	if h == nil {
+		return nil
+	}
+	b, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.WriteAt(append(b, '\n'), 0)
+	return err
+}
+
+// Query returns the holder of the lock on address, or nil if it isn't held.
+func Query(address string, opts ...Option) (*Holder, error) {
+	o := newOptions(opts)
+	return query(filepath.Join(o.dir, fileName(address)))
+}
+
+// List returns the holders of all held locks in the lock directory.
+func List(opts ...Option) ([]Holder, error) {
+	o := newOptions(opts)
+	paths, err := filepath.Glob(filepath.Join(o.dir, "*.lock"))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		holders []Holder
+		errs    []error
+	)
+	for _, path := range paths {
+		h, err := query(path)
+		switch {
+		case err != nil:
+			errs = append(errs, err)
+		case h != nil:
+			holders = append(holders, *h)
+		}
+	}
+	return holders, errors.Join(errs...)
+}
+
+func query(path string) (*Holder, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ok, err := tryLock(f)
+	if err != nil {
+		return nil, fmt.Errorf("error locking %s: %w", path, err)
+	}
+	if ok {
+		return nil, unlock(f)
+	}
+	h, err := readHolder(f)
+	if err != nil || h == nil {
+		// Held by a process that couldn't record itself or is doing so.
+		h = &Holder{Address: strings.TrimSuffix(filepath.Base(path), ".lock")}
+	}
+	return h, nil
+}
+
+// readHolder reads the holder recorded in a lock file, returning nil if there
+// isn't one.
+func readHolder(f *os.File) (*Holder, error) {
+	b, err := io.ReadAll(io.NewSectionReader(f, 0, 1<<16))
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	var h Holder
+	if err := json.Unmarshal(b, &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func self(address string) Holder {
+	h := Holder{
+		Address: address,
+		PID:     os.Getpid(),
+		Command: filepath.Base(os.Args[0]),
+		Since:   time.Now(),
+	}
+	if u, err := user.Current(); err == nil {
+		h.User = u.Username
+	}
+	h.Host, _ = os.Hostname()
+	return h
+}
+
+// fileName returns the lock file name for address: the address with the
+// characters that aren't safe in file names replaced, followed by a hash of
+// the address so different addresses never share a file.
+func fileName(address string) string {
+	address = key(address)
+	safe := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, address)
+	sum := sha256.Sum256([]byte(address))
+	return fmt.Sprintf("%s-%s.lock", safe, hex.EncodeToString(sum[:4]))
+}
+
+// key returns the form of address that identifies its lock. The vendor and
+// product IDs of USB addresses, which may be written in decimal or hex, are
+// written in hex. A serial address, ASRL::<port>::<baud>::<framing>::INSTR, is
+// keyed on its port alone, which it then shares with a Prologix controller on
+// the port, since only one process at a time can open it at any settings.
+func key(address string) string {
+	address = strings.ToUpper(strings.TrimSpace(address))
+	fields := strings.Split(address, "::")
+	if fields[0] == "ASRL" && len(fields) >= 2 {
+		return fields[1]
+	}
+	if strings.HasPrefix(fields[0], "USB") && len(fields) >= 4 {
+		for i := 1; i <= 2; i++ {
+			if id, err := strconv.ParseUint(fields[i], 0, 16); err == nil {
+				fields[i] = fmt.Sprintf("0x%04X", id)
+			}
+		}
+		return strings.Join(fields, "::")
+	}
+	return address
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package instlock
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Transport is the part of an ivi.Transport used for the instrument's own
+// lock.
+type Transport interface {
+	Command(ctx context.Context, cmd string, a ...any) error
+	Query(ctx context.Context, cmd string) (string, error)
+}
+
+// RequestSCPI requests the lock of a Keysight instrument with
+// SYSTem:LOCK:REQuest?, which the instrument grants to one I/O interface at a
+// time, e.g., LAN or USB, and which also locks out the front panel. It retries
+// until ctx is done, when the error names the owning interface.
+func RequestSCPI(ctx context.Context, t Transport) error {
+	for {
+		resp, err := t.Query(ctx, "SYST:LOCK:REQ?")
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(resp) == "1" {
+			return nil
+		}
+		select {
+		case <-time.After(DefaultPoll):
+		case <-ctx.Done():
+			owner, err := OwnerSCPI(context.WithoutCancel(ctx), t)
+			if err != nil {
+				return fmt.Errorf("%w by the instrument (owner unknown: %w)", ErrLocked, err)
+			}
+			return fmt.Errorf("%w by the instrument's %s interface", ErrLocked, owner)
+		}
+	}
+}
+
+// ReleaseSCPI releases the instrument's lock with SYSTem:LOCK:RELease.
+func ReleaseSCPI(ctx context.Context, t Transport) error {
+	return t.Command(ctx, "SYST:LOCK:REL")
+}
+
+// OwnerSCPI returns the interface holding the instrument's lock, e.g., "LAN0",
+// "USB", or "NONE".
+func OwnerSCPI(ctx context.Context, t Transport) (string, error) {
+	resp, err := t.Query(ctx, "SYST:LOCK:OWN?")
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(strings.TrimSpace(resp), `"`), nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package limits wraps DC power supplies and function generators so that
+// setpoints beyond the maximums configured for a bench are rejected before
+// they reach the instrument, guarding the devices under test against typos
+// such as 50 V instead of 5.0 V. Every rejected attempt is logged.
+//
+// A limits file looks like:
+//
+//	{
+//	  "maxVoltage": 30,
+//	  "maxCurrent": 3,
+//	  "maxAmplitude": 5,
+//	  "maxOffset": 2.5,
+//	  "instruments": {
+//	    "pmx": {"maxVoltage": 55}
+//	  }
+//	}
+//
+// The limits at the top apply to every instrument; those under instruments
+// override them for the named instrument. A missing or zero limit isn't
+// enforced.
+package limits
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+// ErrLimitExceeded matches every *LimitError with errors.Is.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// LimitError reports a rejected setpoint.
+type LimitError struct {
+	Instrument string
+	Channel    string
+	Setting    string // e.g., "voltage" or "amplitude"
+	Value      float64
+	Limit      float64 // zero if Value was rejected for not being finite
+	Unit       string
+}
+
+// Error implements the error interface.
+func (e *LimitError) Error() string {
+	if e.Limit == 0 {
+		return fmt.Sprintf("%s %s: %s %g isn't a finite number", e.Instrument, e.Channel, e.Setting, e.Value)
+	}
+	return fmt.Sprintf("%s %s: %s %g %s exceeds the %g %s limit",
+		e.Instrument, e.Channel, e.Setting, e.Value, e.Unit, e.Limit, e.Unit)
+}
+
+// Is reports whether target is ErrLimitExceeded.
+func (e *LimitError) Is(target error) bool { return target == ErrLimitExceeded }
+
+// Limits are the maximum magnitudes of the setpoints.
+type Limits struct {
+	MaxVoltage   float64 `json:"maxVoltage,omitempty"`   // V, including the OVP limit
+	MaxCurrent   float64 `json:"maxCurrent,omitempty"`   // A
+	MaxAmplitude float64 `json:"maxAmplitude,omitempty"` // Vpp
+	MaxOffset    float64 `json:"maxOffset,omitempty"`    // V
+}
+
+// merge returns l with the non-zero limits of o.
+func (l Limits) merge(o Limits) Limits {
+	for _, p := range []struct{ dst, src *float64 }{
+		{&l.MaxVoltage, &o.MaxVoltage},
+		{&l.MaxCurrent, &o.MaxCurrent},
+		{&l.MaxAmplitude, &o.MaxAmplitude},
+		{&l.MaxOffset, &o.MaxOffset},
+	} {
+		if *p.src != 0 {
+			*p.dst = *p.src
+		}
+	}
+	return l
+}
+
+func (l Limits) validate() error {
+	if l.MaxVoltage < 0 || l.MaxCurrent < 0 || l.MaxAmplitude < 0 || l.MaxOffset < 0 {
+		return errors.New("negative limit")
+	}
+	return nil
+}
+
+// Config holds the limits of a bench.
+type Config struct {
+	Limits
+	// Instruments overrides the limits for the named instruments.
+	Instruments map[string]Limits `json:"instruments,omitempty"`
+}
+
+// For returns the limits of the named instrument.
+func (c Config) For(instrument string) Limits {
+	return c.Limits.merge(c.Instruments[instrument])
+}
+
+// LoadConfig reads and validates a JSON limits file.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	errs := []error{cfg.Limits.validate()}
+	for name, l := range cfg.Instruments {
+		if err := l.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("instrument %q: %w", name, err))
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		return cfg, fmt.Errorf("invalid limits %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Option configures the wrappers.
+type Option func(*guard)
+
+// WithLogger sets the logger used to report rejected setpoints. The default
+// is the standard logger.
+func WithLogger(l *log.Logger) Option { return func(g *guard) { g.logger = l } }
+
+// Apply wraps the DC power supplies and function generators of a bench with
+// the limits configured for each.
+func Apply(b *bench.Bench, cfg Config, opts ...Option) {
+	b.Wrap(func(inst *bench.Instrument, drv bench.Inherent) bench.Inherent {
+		switch d := drv.(type) {
+		case bench.DCPwr:
+			return NewDCPwr(d, inst.Name, cfg.For(inst.Name), opts...)
+		case bench.FGen:
+			return NewFGen(d, inst.Name, cfg.For(inst.Name), opts...)
+		}
+		return drv
+	})
+}
+
+// Flag defines the -limits flag, which names the limits file of a command.
+func Flag() *string {
+	return flag.String("limits", "", "JSON file of the setpoint limits to enforce (empty = none)")
+}
+
+// ApplyFile applies the limits read from a file, if path isn't empty, to the
+// bench.
+func ApplyFile(b *bench.Bench, path string, opts ...Option) error {
+	if path == "" {
+		return nil
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	Apply(b, cfg, opts...)
+	return nil
+}
+
+// guard checks setpoints against the limits of one instrument.
+type guard struct {
+	instrument string
+	limits     Limits
+	logger     *log.Logger
+}
+
+func newGuard(instrument string, l Limits, opts []Option) *guard {
+	g := guard{instrument: instrument, limits: l, logger: log.Default()}
+	for _, opt := range opts {
+		opt(&g)
+	}
+	return &g
+}
+
+// check rejects a setpoint whose magnitude exceeds the limit, unless the
+// limit is zero, and any setpoint that isn't a finite number.
+func (g *guard) check(channel, setting string, v, limit float64, unit string) error {
+	err := LimitError{
+		Instrument: g.instrument,
+		Channel:    channel,
+		Setting:    setting,
+		Value:      v,
+		Unit:       unit,
+	}
+	switch {
+	case math.IsNaN(v) || math.IsInf(v, 0):
+	case limit > 0 && math.Abs(v) > limit:
+		err.Limit = limit
+	default:
+		return nil
+	}
+	g.logger.Printf("rejected setpoint: %s", &err)
+	return &err
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package limits
+
+import (
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/fgen"
+)
+
+// The wrappers embed the class interfaces, so the methods that don't take a
+// setpoint pass straight through to the driver. They deliberately don't
+// provide Unwrap, which would let callers bypass the limits.
+
+// NewDCPwr wraps a DC power supply so its channels reject voltage, current
+// limit, and OVP limit setpoints beyond l. The OVP limit is checked against
+// MaxVoltage, since a higher OVP limit wouldn't protect the device under
+// test. The instrument name is used in the errors.
+func NewDCPwr(ps bench.DCPwr, instrument string, l Limits, opts ...Option) bench.DCPwr {
+	return dcpwrLimiter{DCPwr: ps, g: newGuard(instrument, l, opts)}
+}
+
+type dcpwrLimiter struct {
+	bench.DCPwr
+	g *guard
+}
+
+func (p dcpwrLimiter) Channel(i int) (bench.DCPwrChannel, error) {
+	ch, err := p.DCPwr.Channel(i)
+	if err != nil {
+		return nil, err
+	}
+	return dcpwrChannel{DCPwrChannel: ch, g: p.g}, nil
+}
+
+type dcpwrChannel struct {
+	bench.DCPwrChannel
+	g *guard
+}
+
+func (c dcpwrChannel) SetVoltageLevel(v float64) error {
+	if err := c.g.check(c.Name(), "voltage", v, c.g.limits.MaxVoltage, "V"); err != nil {
+		return err
+	}
+	return c.DCPwrChannel.SetVoltageLevel(v)
+}
+
+func (c dcpwrChannel) SetCurrentLimit(a float64) error {
+	if err := c.g.check(c.Name(), "current limit", a, c.g.limits.MaxCurrent, "A"); err != nil {
+		return err
+	}
+	return c.DCPwrChannel.SetCurrentLimit(a)
+}
+
+// ConfigureOVP checks the limit only when OVP is being enabled.
+func (c dcpwrChannel) ConfigureOVP(enabled bool, limit float64) error {
+	if enabled {
+		if err := c.g.check(c.Name(), "OVP limit", limit, c.g.limits.MaxVoltage, "V"); err != nil {
+			return err
+		}
+	}
+	return c.DCPwrChannel.ConfigureOVP(enabled, limit)
+}
+
+// NewFGen wraps a function generator so its channels reject amplitude and DC
+// offset setpoints beyond l. The instrument name is used in the errors.
+func NewFGen(fg bench.FGen, instrument string, l Limits, opts ...Option) bench.FGen {
+	return fgenLimiter{FGen: fg, g: newGuard(instrument, l, opts)}
+}
+
+type fgenLimiter struct {
+	bench.FGen
+	g *guard
+}
+
+func (f fgenLimiter) Channel(i int) (bench.FGenChannel, error) {
+	ch, err := f.FGen.Channel(i)
+	if err != nil {
+		return nil, err
+	}
+	return fgenChannel{FGenChannel: ch, g: f.g}, nil
+}
+
+type fgenChannel struct {
+	bench.FGenChannel
+	g *guard
+}
+
+func (c fgenChannel) SetAmplitude(amp float64) error {
+	if err := c.g.check(c.Name(), "amplitude", amp, c.g.limits.MaxAmplitude, "Vpp"); err != nil {
+		return err
+	}
+	return c.FGenChannel.SetAmplitude(amp)
+}
+
+func (c fgenChannel) SetDCOffset(offset float64) error {
+	if err := c.g.check(c.Name(), "DC offset", offset, c.g.limits.MaxOffset, "V"); err != nil {
+		return err
+	}
+	return c.FGenChannel.SetDCOffset(offset)
+}
+
+func (c fgenChannel) ConfigureStandardWaveform(
+	wave fgen.StandardWaveform,
+	amp, offset, freq, phase float64,
+) error {
+	if err := c.g.check(c.Name(), "amplitude", amp, c.g.limits.MaxAmplitude, "Vpp"); err != nil {
+		return err
+	}
+	if err := c.g.check(c.Name(), "DC offset", offset, c.g.limits.MaxOffset, "V"); err != nil {
+		return err
+	}
+	return c.FGenChannel.ConfigureStandardWaveform(wave, amp, offset, freq, phase)
+}
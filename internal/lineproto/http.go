@@ -0,0 +1,171 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package lineproto
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPWriter pushes points in batches to an InfluxDB-compatible write
+// endpoint. A batch is sent once it holds the batch size or its oldest point
+// is older than the flush interval. If a batch can't be sent, its points are
+// kept and retried after the flush interval, up to the maximum number of
+// pending points, after which the oldest are dropped.
+type HTTPWriter struct {
+	url           string
+	token         string
+	client        *http.Client
+	batchSize     int
+	flushInterval time.Duration
+	maxPending    int
+
+	buf     []byte
+	ends    []int // end offset in buf of each pending line
+	oldest  time.Time
+	retryAt time.Time
+	dropped int
+}
+
+// Option configures an HTTPWriter.
+type Option func(*HTTPWriter)
+
+// WithToken sets the API token sent in the Authorization header, as used by
+// InfluxDB 2 and 3.
+func WithToken(token string) Option { return func(w *HTTPWriter) { w.token = token } }
+
+// WithBatchSize sets the number of points sent in each request. The default
+// is 1000.
+func WithBatchSize(n int) Option { return func(w *HTTPWriter) { w.batchSize = n } }
+
+// WithFlushInterval sets the longest a point waits before being sent. The
+// default is 10 s.
+func WithFlushInterval(d time.Duration) Option {
+	return func(w *HTTPWriter) { w.flushInterval = d }
+}
+
+// WithMaxPending sets the number of points kept while the endpoint is
+// unreachable. The default is 100,000.
+func WithMaxPending(n int) Option { return func(w *HTTPWriter) { w.maxPending = n } }
+
+// WithHTTPClient sets the HTTP client. The default has a 10 s timeout.
+func WithHTTPClient(c *http.Client) Option { return func(w *HTTPWriter) { w.client = c } }
+
+// NewHTTPWriter creates an HTTPWriter posting to the write URL, e.g.,
+// "http://localhost:8086/write?db=lab" for InfluxDB 1 or
+// "http://localhost:8086/api/v2/write?org=lab&bucket=bench" for InfluxDB 2.
+// The timestamps are in nanoseconds, the default precision for both.
+func NewHTTPWriter(url string, opts ...Option) *HTTPWriter {
+	w := HTTPWriter{
+		url:           url,
+		client:        &http.Client{Timeout: 10 * time.Second},
+		batchSize:     1000,
+		flushInterval: 10 * time.Second,
+		maxPending:    100_000,
+	}
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return &w
+}
+
+// Write adds a point to the pending batch and sends the batch if it's full or
+// due. An error sending the batch doesn't lose the points.
+func (w *HTTPWriter) Write(ctx context.Context, p Point) error {
+	var err error
+	if w.buf, err = Append(w.buf, p); err != nil {
+		return err
+	}
+	if len(w.ends) == 0 {
+		w.oldest = time.Now()
+	}
+	w.ends = append(w.ends, len(w.buf))
+	if len(w.ends) > w.maxPending {
+		w.drop(len(w.ends) - w.maxPending)
+	}
+	if len(w.ends) < w.batchSize && time.Since(w.oldest) < w.flushInterval {
+		return nil
+	}
+	if time.Now().Before(w.retryAt) {
+		return nil
+	}
+	return w.send(ctx, false)
+}
+
+// Flush sends all pending points, even if a previous attempt failed less
+// than a flush interval ago.
+func (w *HTTPWriter) Flush(ctx context.Context) error { return w.send(ctx, true) }
+
+// Pending returns the number of points not yet sent.
+func (w *HTTPWriter) Pending() int { return len(w.ends) }
+
+// send sends the pending points a batch at a time. Unless all is set, a final
+// partial batch that isn't due is left pending.
+func (w *HTTPWriter) send(ctx context.Context, all bool) error {
+	for len(w.ends) > 0 {
+		n := min(len(w.ends), w.batchSize)
+		if !all && n < w.batchSize && time.Since(w.oldest) < w.flushInterval {
+			return nil
+		}
+		if err := w.post(ctx, w.buf[:w.ends[n-1]]); err != nil {
+			w.retryAt = time.Now().Add(w.flushInterval)
+			return err
+		}
+		// The remaining points are newer than those sent, so restarting the
+		// interval delays them by at most one interval.
+		w.remove(n)
+		w.oldest = time.Now()
+	}
+	if w.dropped > 0 {
+		err := fmt.Errorf("lineproto: dropped %d points while %s was unreachable", w.dropped, w.url)
+		w.dropped = 0
+		return err
+	}
+	return nil
+}
+
+// drop discards the oldest n pending lines. The remaining lines are newer, so
+// the flush interval restarts as when a batch is sent.
+func (w *HTTPWriter) drop(n int) {
+	w.remove(n)
+	w.dropped += n
+	w.oldest = time.Now()
+}
+
+// remove removes the first n pending lines.
+func (w *HTTPWriter) remove(n int) {
+	end := w.ends[n-1]
+	w.buf = w.buf[:copy(w.buf, w.buf[end:])]
+	w.ends = w.ends[:copy(w.ends, w.ends[n:])]
+	for i := range w.ends {
+		w.ends[i] -= end
+	}
+}
+
+func (w *HTTPWriter) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Token "+w.token)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("lineproto: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("lineproto: %s: %s: %s", w.url, resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package lineproto writes timestamped readings in the InfluxDB line
+// protocol, either to a file or pushed in batches to an InfluxDB-compatible
+// HTTP write endpoint.
+package lineproto
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tag is a tag key and value.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Field is a field key and float value.
+type Field struct {
+	Key   string
+	Value float64
+}
+
+// Point is one line of line protocol. Tags with empty values are omitted, as
+// line protocol doesn't allow them.
+type Point struct {
+	Measurement string
+	Tags        []Tag
+	Fields      []Field
+	Time        time.Time
+}
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `, "\n", `\n`)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`)
+)
+
+// Append appends the point as a line, including the trailing newline, to dst.
+// Tags are sorted by key as recommended for write performance, and the
+// timestamp is in nanoseconds. On error, dst is returned unchanged.
+func Append(dst []byte, p Point) ([]byte, error) {
+	start := len(dst)
+	if p.Measurement == "" {
+		return dst, errors.New("lineproto: missing measurement")
+	}
+	if len(p.Fields) == 0 {
+		return dst, fmt.Errorf("lineproto: %s has no fields", p.Measurement)
+	}
+	dst = append(dst, measurementEscaper.Replace(p.Measurement)...)
+	tags := slices.SortedFunc(slices.Values(p.Tags), func(a, b Tag) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	for _, tag := range tags {
+		if tag.Value == "" {
+			continue
+		}
+		dst = append(dst, ',')
+		dst = append(dst, keyEscaper.Replace(tag.Key)...)
+		dst = append(dst, '=')
+		dst = append(dst, keyEscaper.Replace(tag.Value)...)
+	}
+	for i, f := range p.Fields {
+		if math.IsNaN(f.Value) || math.IsInf(f.Value, 0) {
+			return dst[:start], fmt.Errorf("lineproto: %s field %s is %v", p.Measurement, f.Key, f.Value)
+		}
+		if i == 0 {
+			dst = append(dst, ' ')
+		} else {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, keyEscaper.Replace(f.Key)...)
+		dst = append(dst, '=')
+		dst = strconv.AppendFloat(dst, f.Value, 'g', -1, 64)
+	}
+	if !p.Time.IsZero() {
+		dst = append(dst, ' ')
+		dst = strconv.AppendInt(dst, p.Time.UnixNano(), 10)
+	}
+	return append(dst, '\n'), nil
+}
+
+// Encoder writes points to an io.Writer such as a file.
+type Encoder struct {
+	w   *bufio.Writer
+	buf []byte
+}
+
+// NewEncoder creates an Encoder writing to w. Points are buffered until
+// Flush.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a point.
+func (e *Encoder) Encode(p Point) error {
+	var err error
+	if e.buf, err = Append(e.buf[:0], p); err != nil {
+		return err
+	}
+	_, err = e.w.Write(e.buf)
+	return err
+}
+
+// Flush writes any buffered points to the underlying writer.
+func (e *Encoder) Flush() error { return e.w.Flush() }
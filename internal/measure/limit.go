@@ -0,0 +1,107 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package measure
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Limit is the range of acceptable values of a measurement. A nil Min or Max
+// leaves that side unbounded.
+type Limit struct {
+	Min *float64
+	Max *float64
+	// Nominal is set by Tolerance and TolerancePercent so the limit is
+	// shown as the nominal value and tolerance.
+	Nominal *float64
+	percent float64
+}
+
+// Range returns the limit lo <= v <= hi.
+func Range(lo, hi float64) Limit { return Limit{Min: &lo, Max: &hi} }
+
+// AtLeast returns the limit v >= lo.
+func AtLeast(lo float64) Limit { return Limit{Min: &lo} }
+
+// AtMost returns the limit v <= hi.
+func AtMost(hi float64) Limit { return Limit{Max: &hi} }
+
+// Tolerance returns the limit nominal ± tol.
+func Tolerance(nominal, tol float64) Limit {
+	tol = math.Abs(tol)
+	lo, hi := nominal-tol, nominal+tol
+	return Limit{Min: &lo, Max: &hi, Nominal: &nominal}
+}
+
+// TolerancePercent returns the limit nominal ± pct percent of nominal.
+func TolerancePercent(nominal, pct float64) Limit {
+	l := Tolerance(nominal, nominal*pct/100)
+	l.percent = math.Abs(pct)
+	return l
+}
+
+// Contains reports whether v is within the limit. NaN is never within a
+// limit.
+func (l Limit) Contains(v float64) bool {
+	return !math.IsNaN(v) &&
+		(l.Min == nil || v >= *l.Min) &&
+		(l.Max == nil || v <= *l.Max)
+}
+
+// Format formats the limit in unit, e.g., "5 V ± 100 mV", "[4.9 V, 5.1 V]",
+// or "<= 300 mA". It returns "" if the limit is unbounded.
+func (l Limit) Format(unit Unit) string {
+	f := func(v float64) string { return Format(v, unit, DefaultPrecision) }
+	switch {
+	case l.Nominal != nil && l.percent != 0:
+		return fmt.Sprintf("%s ± %g%%", f(*l.Nominal), l.percent)
+	case l.Nominal != nil && l.Max != nil:
+		return fmt.Sprintf("%s ± %s", f(*l.Nominal), f(*l.Max-*l.Nominal))
+	case l.Min != nil && l.Max != nil:
+		return fmt.Sprintf("[%s, %s]", f(*l.Min), f(*l.Max))
+	case l.Min != nil:
+		return ">= " + f(*l.Min)
+	case l.Max != nil:
+		return "<= " + f(*l.Max)
+	}
+	return ""
+}
+
+// Result is a measurement checked against a limit.
+type Result struct {
+	Name string
+	Measurement
+	Limit Limit
+	Pass  bool
+	Time  time.Time
+}
+
+// Check checks a measurement against a limit.
+func Check(name string, m Measurement, l Limit) Result {
+	return Result{Name: name, Measurement: m, Limit: l, Pass: l.Contains(m.Value), Time: time.Now()}
+}
+
+// Verdict returns "PASS" or "FAIL".
+func Verdict(pass bool) string {
+	if pass {
+		return "PASS"
+	}
+	return "FAIL"
+}
+
+// Verdict returns the verdict of the result.
+func (r Result) Verdict() string { return Verdict(r.Pass) }
+
+// String formats the result, e.g., "5 V rail: 4.9987 V, limits 5 V ± 100 mV:
+// PASS".
+func (r Result) String() string {
+	if l := r.Limit.Format(r.Unit); l != "" {
+		return fmt.Sprintf("%s: %s, limits %s: %s", r.Name, r.Measurement, l, r.Verdict())
+	}
+	return fmt.Sprintf("%s: %s: %s", r.Name, r.Measurement, r.Verdict())
+}
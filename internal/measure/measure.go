@@ -0,0 +1,114 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package measure attaches units to the readings returned by the IVI drivers,
+// formats them with SI prefixes, and checks them against limits given either
+// as a nominal value and tolerance or as a minimum and maximum:
+//
+//	v, err := ch.MeasureVoltage()
+//	res := measure.Check("5 V rail", measure.Voltage(v), measure.Tolerance(5, 0.1))
+//	log.Println(res) // 5 V rail: 4.9987 V, limits 5 V ± 100 mV: PASS
+package measure
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/gotmc/ivi/dmm"
+)
+
+// Unit is the symbol of a unit of measurement.
+type Unit string
+
+// Units of the quantities measured by the IVI instrument classes.
+const (
+	Volt           Unit = "V"
+	VoltPeakToPeak Unit = "Vpp"
+	Ampere         Unit = "A"
+	Ohm            Unit = "Ω"
+	Hertz          Unit = "Hz"
+	Second         Unit = "s"
+	DegreeCelsius  Unit = "°C"
+	Decibel        Unit = "dB"
+	Unitless       Unit = ""
+)
+
+// DefaultPrecision is the number of significant digits used by String.
+const DefaultPrecision = 6
+
+// DMMUnit returns the unit of the readings of a DMM measurement function.
+func DMMUnit(fcn dmm.MeasurementFunction) Unit {
+	switch fcn {
+	case dmm.DCVolts, dmm.ACVolts:
+		return Volt
+	case dmm.DCCurrent, dmm.ACCurrent:
+		return Ampere
+	case dmm.TwoWireResistance, dmm.FourWireResistance:
+		return Ohm
+	case dmm.Frequency:
+		return Hertz
+	case dmm.Period:
+		return Second
+	case dmm.Temperature:
+		return DegreeCelsius
+	}
+	return Unitless
+}
+
+// Measurement is a reading with its unit.
+type Measurement struct {
+	Value float64
+	Unit  Unit
+}
+
+// DMM returns a reading taken with a DMM measurement function.
+func DMM(fcn dmm.MeasurementFunction, v float64) Measurement {
+	return Measurement{Value: v, Unit: DMMUnit(fcn)}
+}
+
+// Voltage returns a DC power supply voltage, such as a measured output
+// voltage or a voltage level.
+func Voltage(v float64) Measurement { return Measurement{Value: v, Unit: Volt} }
+
+// Current returns a DC power supply current, such as a measured output
+// current or a current limit.
+func Current(a float64) Measurement { return Measurement{Value: a, Unit: Ampere} }
+
+// String formats the measurement with an SI prefix and DefaultPrecision
+// significant digits, e.g., "12.5 mA".
+func (m Measurement) String() string { return Format(m.Value, m.Unit, DefaultPrecision) }
+
+var prefixes = []string{"f", "p", "n", "µ", "m", "", "k", "M", "G", "T"}
+
+const unprefixed = 5 // index of "" in prefixes
+
+// Format formats v in unit with an SI prefix and the given number of
+// significant digits, at least 3, dropping trailing zeros. For example, 0.0125
+// A is "12.5 mA" and 4.7e6 Ω is "4.7 MΩ". Degrees Celsius, decibels, and
+// unitless values aren't prefixed, and neither are values beyond the range of
+// the prefixes.
+func Format(v float64, unit Unit, digits int) string {
+	digits = max(digits, 3)
+	if math.IsNaN(v) || math.IsInf(v, 0) || v == 0 ||
+		unit == DegreeCelsius || unit == Decibel || unit == Unitless {
+		return join(strconv.FormatFloat(v, 'g', digits, 64), "", unit)
+	}
+	// Round first so that, e.g., 999.9996 with 6 digits becomes 1 k, not
+	// 1000.
+	v, _ = strconv.ParseFloat(strconv.FormatFloat(v, 'e', digits-1, 64), 64)
+	i := int(math.Floor(math.Log10(math.Abs(v))/3)) + unprefixed
+	if i < 0 || i >= len(prefixes) {
+		return join(strconv.FormatFloat(v, 'g', digits, 64), "", unit)
+	}
+	scaled := v / math.Pow(1000, float64(i-unprefixed))
+	return join(strconv.FormatFloat(scaled, 'g', digits, 64), prefixes[i], unit)
+}
+
+func join(number, prefix string, unit Unit) string {
+	if unit == Unitless {
+		return number
+	}
+	return number + " " + prefix + string(unit)
+}
@@ -0,0 +1,558 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package mqttbridge publishes readings and events from the instruments on a
+// bench to MQTT and applies the commands received on MQTT topics through the
+// IVI drivers.
+//
+// Readings are published for DC power supply outputs (voltage and current),
+// DMMs (the reading in the current measurement function), and oscilloscope
+// channels (the measurements chosen with WithScopeMeasurements). Events are
+// published when an output is enabled or disabled, when a power supply output
+// trips its over-voltage protection or current limit, and for each command.
+// Commands set the voltage, current limit, or output state of a power supply
+// output, or the waveform, frequency, amplitude, offset, or output state of a
+// function generator channel. The payload is the new value as text, e.g., "5.0",
+// "on", or "Square".
+package mqttbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"github.com/gotmc/ivi/dcpwr"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+)
+
+// publishTimeout bounds each publish so a stalled broker doesn't stall
+// polling.
+const publishTimeout = 5 * time.Second
+
+// Bridge connects the instruments on a bench to an MQTT client.
+type Bridge struct {
+	bench         *bench.Bench
+	client        Client
+	topics        Topics
+	interval      time.Duration
+	maxTime       time.Duration
+	scopeChannels []int
+	scopeMeas     []scope.MeasFunction
+	logger        *log.Logger
+}
+
+// Option configures a Bridge.
+type Option func(*Bridge)
+
+// WithTopics sets the topic templates. Templates left empty use the
+// DefaultTopics.
+func WithTopics(t Topics) Option { return func(b *Bridge) { b.topics = t.withDefaults() } }
+
+// WithInterval sets the time between polls of each instrument. The default is
+// 5 s.
+func WithInterval(d time.Duration) Option { return func(b *Bridge) { b.interval = d } }
+
+// WithMaxTime sets the maximum time for a DMM reading or an oscilloscope
+// acquisition. The default is 2 s.
+func WithMaxTime(d time.Duration) Option { return func(b *Bridge) { b.maxTime = d } }
+
+// WithScopeMeasurements sets the measurements published for the given
+// 0-based oscilloscope channels. By default oscilloscopes aren't measured.
+func WithScopeMeasurements(channels []int, fcns ...scope.MeasFunction) Option {
+	return func(b *Bridge) {
+		b.scopeChannels = channels
+		b.scopeMeas = fcns
+	}
+}
+
+// WithLogger sets the logger used to report errors. The default is the
+// standard logger.
+func WithLogger(l *log.Logger) Option { return func(b *Bridge) { b.logger = l } }
+
+// New creates a bridge between the instruments on the bench and the client.
+func New(bn *bench.Bench, c Client, opts ...Option) *Bridge {
+	b := Bridge{
+		bench:    bn,
+		client:   c,
+		topics:   DefaultTopics,
+		interval: 5 * time.Second,
+		maxTime:  2 * time.Second,
+		logger:   log.Default(),
+	}
+	for _, opt := range opts {
+		opt(&b)
+	}
+	return &b
+}
+
+// target is an instrument polled by the bridge along with what's needed to
+// detect events.
+type target struct {
+	inst     *bench.Instrument
+	model    string
+	serial   string
+	channels []string
+	outputs  map[string]*outputStatus
+	states   []dcpwr.OutputState
+	failed   bool
+}
+
+// outputStatus is the last polled status of an output.
+type outputStatus struct {
+	enabled bool
+	ovp     bool
+	limit   bool
+}
+
+// command is a command received on a command topic.
+type command struct {
+	t       *target
+	channel int
+	param   string
+	value   string
+}
+
+// Run polls the instruments and applies commands until ctx is done.
+// Switches aren't bridged.
+func (b *Bridge) Run(ctx context.Context) error {
+	var targets []*target
+	for _, inst := range b.bench.Instruments() {
+		if inst.Class == bench.ClassSwtch {
+			continue
+		}
+		t, err := b.identify(ctx, inst)
+		if err != nil {
+			return fmt.Errorf("error identifying %s: %w", inst.Name, err)
+		}
+		targets = append(targets, t)
+	}
+
+	// Commands are applied one at a time in the order received so that, e.g.,
+	// setting the voltage before enabling the output works as expected.
+	commands := make(chan command, 64)
+	for _, t := range targets {
+		if err := b.subscribe(ctx, t, commands); err != nil {
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Go(func() { b.poll(ctx, t) })
+	}
+	wg.Go(func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case c := <-commands:
+				b.apply(ctx, c)
+			}
+		}
+	})
+	wg.Wait()
+	return nil
+}
+
+// identify queries the model, serial number, and channel names of the
+// instrument.
+func (b *Bridge) identify(ctx context.Context, inst *bench.Instrument) (*target, error) {
+	t := target{
+		inst:    inst,
+		outputs: make(map[string]*outputStatus),
+		states:  []dcpwr.OutputState{dcpwr.OverVoltage, dcpwr.ConstantCurrent, dcpwr.OverCurrent},
+	}
+	err := inst.Do(ctx, func(drv bench.Inherent) error {
+		var err error
+		if t.model, err = drv.InstrumentModel(); err != nil {
+			return fmt.Errorf("error querying instrument model: %w", err)
+		}
+		if t.serial, err = drv.InstrumentSerialNumber(); err != nil {
+			return fmt.Errorf("error querying instrument sn: %w", err)
+		}
+		switch drv := drv.(type) {
+		case bench.DCPwr:
+			t.channels, err = channelNames(drv.OutputChannelCount(), drv.Channel)
+		case bench.FGen:
+			t.channels, err = channelNames(drv.ChannelCount(), drv.Channel)
+		case bench.Scope:
+			t.channels, err = channelNames(drv.ChannelCount(), drv.Channel)
+		}
+		return err
+	})
+	return &t, err
+}
+
+func channelNames[C interface{ Name() string }](n int, get func(int) (C, error)) ([]string, error) {
+	names := make([]string, n)
+	for i := range n {
+		ch, err := get(i)
+		if err != nil {
+			return nil, fmt.Errorf("error getting channel %d: %w", i, err)
+		}
+		names[i] = ch.Name()
+	}
+	return names, nil
+}
+
+// params are the command parameters of each class.
+var params = map[bench.Class][]string{
+	bench.ClassDCPwr: slices.Sorted(maps.Keys(dcpwrParams)),
+	bench.ClassFGen:  slices.Sorted(maps.Keys(fgenParams)),
+}
+
+// subscribe subscribes to the command topics of each channel of the
+// instrument.
+func (b *Bridge) subscribe(ctx context.Context, t *target, commands chan<- command) error {
+	for i, name := range t.channels {
+		for _, param := range params[t.inst.Class] {
+			topic := expand(b.topics.Command,
+				"instrument", t.inst.Name, "channel", name, "param", param)
+			err := b.client.Subscribe(ctx, topic, func(_ string, payload []byte) {
+				c := command{t: t, channel: i, param: param, value: strings.TrimSpace(string(payload))}
+				select {
+				case commands <- c:
+				case <-ctx.Done():
+				}
+			})
+			if err != nil {
+				return fmt.Errorf("error subscribing to %s: %w", topic, err)
+			}
+		}
+	}
+	return nil
+}
+
+// poll polls the instrument every interval until ctx is done.
+func (b *Bridge) poll(ctx context.Context, t *target) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		var (
+			readings []Reading
+			events   []Event
+		)
+		err := t.inst.Do(ctx, func(drv bench.Inherent) error {
+			var err error
+			readings, events, err = b.read(t, drv)
+			return err
+		})
+		switch {
+		case err != nil && ctx.Err() == nil:
+			b.logger.Printf("error polling %s: %s", t.inst.Name, err)
+			if !t.failed {
+				events = append(events, t.event("", InstrumentFailure, err.Error()))
+			}
+			t.failed = true
+		case err == nil:
+			t.failed = false
+		}
+		for _, r := range readings {
+			topic := expand(b.topics.Reading,
+				"instrument", r.Instrument, "channel", r.Channel, "quantity", r.Quantity)
+			b.publish(ctx, topic, r)
+		}
+		for _, e := range events {
+			b.publishEvent(ctx, e)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// read takes the readings and detects the events of the instrument. Readings
+// taken before an error are still returned.
+func (b *Bridge) read(t *target, drv bench.Inherent) ([]Reading, []Event, error) {
+	switch drv := drv.(type) {
+	case bench.DCPwr:
+		return b.readDCPwr(t, drv)
+	case bench.DMM:
+		return b.readDMM(t, drv)
+	case bench.FGen:
+		return b.readFGen(t, drv)
+	case bench.Scope:
+		return b.readScope(t, drv)
+	}
+	return nil, nil, nil
+}
+
+func (b *Bridge) readDCPwr(t *target, ps bench.DCPwr) ([]Reading, []Event, error) {
+	var (
+		readings []Reading
+		events   []Event
+	)
+	for i, name := range t.channels {
+		ch, err := ps.Channel(i)
+		if err != nil {
+			return readings, events, fmt.Errorf("error getting channel %s: %w", name, err)
+		}
+		v, err := ch.MeasureVoltage()
+		if err != nil {
+			return readings, events, fmt.Errorf("error measuring %s voltage: %w", name, err)
+		}
+		readings = append(readings, t.reading(name, "voltage", v, "V"))
+		a, err := ch.MeasureCurrent()
+		if err != nil {
+			return readings, events, fmt.Errorf("error measuring %s current: %w", name, err)
+		}
+		readings = append(readings, t.reading(name, "current", a, "A"))
+
+		var status outputStatus
+		if status.enabled, err = ch.OutputEnabled(); err != nil {
+			return readings, events, fmt.Errorf("error querying %s output: %w", name, err)
+		}
+		supported := t.states[:0:0]
+		for _, state := range t.states {
+			active, err := ch.QueryOutputState(state)
+			if errors.Is(err, ivi.ErrFunctionNotSupported) || errors.Is(err, ivi.ErrNotImplemented) {
+				continue
+			}
+			if err != nil {
+				return readings, events, fmt.Errorf("error querying %s %s state: %w", name, state, err)
+			}
+			supported = append(supported, state)
+			switch state {
+			case dcpwr.OverVoltage:
+				status.ovp = active
+			default:
+				status.limit = status.limit || active
+			}
+		}
+		t.states = supported
+		events = append(events, t.outputEvents(name, status)...)
+	}
+	return readings, events, nil
+}
+
+// outputEvents compares the status of an output with the last poll. No events
+// are reported for the first poll.
+func (t *target) outputEvents(channel string, status outputStatus) []Event {
+	last, ok := t.outputs[channel]
+	t.outputs[channel] = &status
+	if !ok {
+		return nil
+	}
+	var events []Event
+	switch {
+	case status.enabled && !last.enabled:
+		events = append(events, t.event(channel, OutputEnabled, ""))
+	case !status.enabled && last.enabled:
+		events = append(events, t.event(channel, OutputDisabled, ""))
+	}
+	if status.ovp && !last.ovp {
+		events = append(events, t.event(channel, OVPTrip, ""))
+	}
+	if status.limit && !last.limit {
+		events = append(events, t.event(channel, CurrentLimitTrip, ""))
+	}
+	return events
+}
+
+func (b *Bridge) readDMM(t *target, d bench.DMM) ([]Reading, []Event, error) {
+	fcn, err := d.MeasurementFunction()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error querying measurement function: %w", err)
+	}
+	v, err := d.ReadMeasurement(b.maxTime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading measurement: %w", err)
+	}
+	return []Reading{t.reading("", fcn.String(), v, string(measure.DMMUnit(fcn)))}, nil, nil
+}
+
+func (b *Bridge) readFGen(t *target, fg bench.FGen) ([]Reading, []Event, error) {
+	var events []Event
+	for i, name := range t.channels {
+		ch, err := fg.Channel(i)
+		if err != nil {
+			return nil, events, fmt.Errorf("error getting channel %s: %w", name, err)
+		}
+		var status outputStatus
+		if status.enabled, err = ch.OutputEnabled(); err != nil {
+			return nil, events, fmt.Errorf("error querying %s output: %w", name, err)
+		}
+		events = append(events, t.outputEvents(name, status)...)
+	}
+	return nil, events, nil
+}
+
+// readScope acquires a waveform with the first measurement and fetches the
+// other measurements from the same acquisition.
+func (b *Bridge) readScope(t *target, s bench.Scope) ([]Reading, []Event, error) {
+	var readings []Reading
+	for _, i := range b.scopeChannels {
+		ch, err := s.Channel(i)
+		if err != nil {
+			return readings, nil, fmt.Errorf("error getting channel %d: %w", i, err)
+		}
+		for j, fcn := range b.scopeMeas {
+			var v float64
+			if j == 0 {
+				v, err = ch.ReadWaveformMeasurement(fcn, b.maxTime)
+			} else {
+				v, err = ch.FetchWaveformMeasurement(fcn)
+			}
+			if err != nil {
+				return readings, nil, fmt.Errorf("error measuring %s %s: %w", ch.Name(), fcn, err)
+			}
+			readings = append(readings, t.reading(ch.Name(), fcn.String(), v, scopeUnits[fcn]))
+		}
+	}
+	return readings, nil, nil
+}
+
+func (t *target) reading(channel, quantity string, v float64, unit string) Reading {
+	return Reading{
+		Time:       time.Now(),
+		Instrument: t.inst.Name,
+		Model:      t.model,
+		Serial:     t.serial,
+		Channel:    channel,
+		Quantity:   quantity,
+		Value:      v,
+		Unit:       unit,
+	}
+}
+
+func (t *target) event(channel, name, detail string) Event {
+	return Event{
+		Time:       time.Now(),
+		Instrument: t.inst.Name,
+		Channel:    channel,
+		Event:      name,
+		Detail:     detail,
+	}
+}
+
+// apply applies a command and publishes whether it succeeded.
+func (b *Bridge) apply(ctx context.Context, c command) {
+	name := c.t.channels[c.channel]
+	err := c.t.inst.Do(ctx, func(drv bench.Inherent) error {
+		switch drv := drv.(type) {
+		case bench.DCPwr:
+			ch, err := drv.Channel(c.channel)
+			if err != nil {
+				return err
+			}
+			return dcpwrParams[c.param](ch, c.value)
+		case bench.FGen:
+			ch, err := drv.Channel(c.channel)
+			if err != nil {
+				return err
+			}
+			return fgenParams[c.param](ch, c.value)
+		}
+		return nil
+	})
+	detail := fmt.Sprintf("%s=%s", c.param, c.value)
+	if err != nil {
+		b.logger.Printf("error setting %s %s %s: %s", c.t.inst.Name, name, detail, err)
+		b.publishEvent(ctx, c.t.event(name, CommandFailed, fmt.Sprintf("%s: %s", detail, err)))
+		return
+	}
+	b.publishEvent(ctx, c.t.event(name, CommandApplied, detail))
+}
+
+func (b *Bridge) publishEvent(ctx context.Context, e Event) {
+	topic := expand(b.topics.Event,
+		"instrument", e.Instrument, "channel", e.Channel, "event", e.Event)
+	b.publish(ctx, topic, e)
+}
+
+func (b *Bridge) publish(ctx context.Context, topic string, v any) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		b.logger.Printf("error encoding %s payload: %s", topic, err)
+		return
+	}
+	pctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	if err := b.client.Publish(pctx, topic, false, payload); err != nil && ctx.Err() == nil {
+		b.logger.Printf("error publishing %s: %s", topic, err)
+	}
+}
+
+// Command parameters and how they're applied.
+
+var dcpwrParams = map[string]func(ch bench.DCPwrChannel, value string) error{
+	"voltage": floatParam(bench.DCPwrChannel.SetVoltageLevel),
+	"current": floatParam(bench.DCPwrChannel.SetCurrentLimit),
+	"output":  boolParam(bench.DCPwrChannel.SetOutputEnabled),
+}
+
+var fgenParams = map[string]func(ch bench.FGenChannel, value string) error{
+	"waveform": func(ch bench.FGenChannel, value string) error {
+		wave, err := bench.ParseEnum[fgen.StandardWaveform](value)
+		if err != nil {
+			return err
+		}
+		return ch.SetStandardWaveform(wave)
+	},
+	"frequency": floatParam(bench.FGenChannel.SetFrequency),
+	"amplitude": floatParam(bench.FGenChannel.SetAmplitude),
+	"offset":    floatParam(bench.FGenChannel.SetDCOffset),
+	"output": boolParam(func(ch bench.FGenChannel, b bool) error {
+		if b {
+			return ch.EnableOutput()
+		}
+		return ch.DisableOutput()
+	}),
+}
+
+func floatParam[C any](set func(C, float64) error) func(C, string) error {
+	return func(ch C, value string) error {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", value)
+		}
+		return set(ch, v)
+	}
+}
+
+func boolParam[C any](set func(C, bool) error) func(C, string) error {
+	return func(ch C, value string) error {
+		switch strings.ToLower(value) {
+		case "1", "on", "true":
+			return set(ch, true)
+		case "0", "off", "false":
+			return set(ch, false)
+		}
+		return fmt.Errorf("invalid output state %q (want on or off)", value)
+	}
+}
+
+var scopeUnits = map[scope.MeasFunction]string{
+	scope.RiseTime:          "s",
+	scope.FallTime:          "s",
+	scope.Frequency:         "Hz",
+	scope.Period:            "s",
+	scope.VoltageRMS:        "V",
+	scope.VoltagePeakToPeak: "V",
+	scope.VoltageMax:        "V",
+	scope.VoltageMin:        "V",
+	scope.VoltageHigh:       "V",
+	scope.VoltageLow:        "V",
+	scope.VoltageAverage:    "V",
+	scope.WidthNegative:     "s",
+	scope.WidthPositive:     "s",
+	scope.DutyCycleNegative: "%",
+	scope.DutyCyclePositive: "%",
+	scope.Amplitude:         "V",
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package mqttbridge
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dcpwr"
+)
+
+// fakePSU is a single-output power supply whose measured voltage follows the
+// programmed level while the output is enabled.
+type fakePSU struct {
+	mu      sync.Mutex
+	volts   float64
+	amps    float64
+	enabled bool
+}
+
+func (p *fakePSU) InstrumentManufacturer() (string, error) { return "Fake", nil }
+func (p *fakePSU) InstrumentModel() (string, error)        { return "PSU1", nil }
+func (p *fakePSU) InstrumentSerialNumber() (string, error) { return "SN1", nil }
+func (p *fakePSU) FirmwareRevision() (string, error)       { return "1.0", nil }
+func (p *fakePSU) Clear() error                            { return nil }
+func (p *fakePSU) Reset() error                            { return nil }
+func (p *fakePSU) Close() error                            { return nil }
+func (p *fakePSU) OutputChannelCount() int                 { return 1 }
+
+func (p *fakePSU) Channel(i int) (bench.DCPwrChannel, error) { return fakeOutput{p}, nil }
+
+func (p *fakePSU) voltage() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.volts
+}
+
+type fakeOutput struct{ p *fakePSU }
+
+func (o fakeOutput) Name() string { return "P6V" }
+
+func (o fakeOutput) VoltageLevel() (float64, error) { return o.p.voltage(), nil }
+
+func (o fakeOutput) SetVoltageLevel(v float64) error {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	o.p.volts = v
+	return nil
+}
+
+func (o fakeOutput) CurrentLimit() (float64, error) {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	return o.p.amps, nil
+}
+
+func (o fakeOutput) SetCurrentLimit(a float64) error {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	o.p.amps = a
+	return nil
+}
+
+func (o fakeOutput) OutputEnabled() (bool, error) {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	return o.p.enabled, nil
+}
+
+func (o fakeOutput) SetOutputEnabled(b bool) error {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	o.p.enabled = b
+	return nil
+}
+
+func (o fakeOutput) OVPEnabled() (bool, error)        { return false, nil }
+func (o fakeOutput) OVPLimit() (float64, error)       { return 0, nil }
+func (o fakeOutput) ConfigureOVP(bool, float64) error { return nil }
+
+func (o fakeOutput) MeasureVoltage() (float64, error) {
+	o.p.mu.Lock()
+	defer o.p.mu.Unlock()
+	if !o.p.enabled {
+		return 0, nil
+	}
+	return o.p.volts, nil
+}
+
+func (o fakeOutput) MeasureCurrent() (float64, error) { return 0, nil }
+
+func (o fakeOutput) QueryOutputState(dcpwr.OutputState) (bool, error) {
+	return false, ivi.ErrFunctionNotSupported
+}
+
+// message is a message received from the broker.
+type message struct {
+	topic   string
+	payload []byte
+}
+
+// startBridge runs a bridge for a bench with the fake power supply against an
+// in-process broker and returns the client along with the messages published
+// under ivi/.
+func startBridge(t *testing.T, psu *fakePSU) (Client, <-chan message) {
+	t.Helper()
+	broker, err := NewBroker("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { broker.Close() })
+	client := broker.Client()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	msgs := make(chan message, 256)
+	err = client.Subscribe(ctx, "ivi/#", func(topic string, payload []byte) {
+		select {
+		case msgs <- message{topic, payload}:
+		case <-ctx.Done():
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bn := bench.New(bench.NewInstrument("psu", bench.ClassDCPwr, psu, nil))
+	b := New(bn, client, WithInterval(10*time.Millisecond))
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		if err := b.Run(ctx); err != nil {
+			t.Errorf("Run: %s", err)
+		}
+	})
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+	return client, msgs
+}
+
+// await returns the payload of the first message published on topic for which
+// ok returns true.
+func await[T any](t *testing.T, msgs <-chan message, topic string, ok func(T) bool) T {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case m := <-msgs:
+			if m.topic != topic {
+				continue
+			}
+			var v T
+			if err := json.Unmarshal(m.payload, &v); err != nil {
+				t.Fatalf("%s: error decoding %q: %s", topic, m.payload, err)
+			}
+			if ok(v) {
+				return v
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %s", topic)
+		}
+	}
+}
+
+func TestReadings(t *testing.T) {
+	psu := &fakePSU{volts: 5, enabled: true}
+	_, msgs := startBridge(t, psu)
+
+	r := await(t, msgs, "ivi/psu/P6V/voltage", func(Reading) bool { return true })
+	if r.Value != 5 || r.Unit != "V" || r.Model != "PSU1" || r.Serial != "SN1" {
+		t.Errorf("voltage reading = %+v, want 5 V from PSU1 SN1", r)
+	}
+	r = await(t, msgs, "ivi/psu/P6V/current", func(Reading) bool { return true })
+	if r.Unit != "A" {
+		t.Errorf("current reading unit = %q, want A", r.Unit)
+	}
+}
+
+func TestSetVoltage(t *testing.T) {
+	psu := &fakePSU{volts: 1, enabled: true}
+	client, msgs := startBridge(t, psu)
+
+	// The bridge subscribes to the command topics before it first polls.
+	await(t, msgs, "ivi/psu/P6V/voltage", func(Reading) bool { return true })
+	ctx := context.Background()
+	if err := client.Publish(ctx, "ivi/psu/P6V/set/voltage", false, []byte("3.3")); err != nil {
+		t.Fatal(err)
+	}
+
+	e := await(t, msgs, "ivi/psu/P6V/event", func(e Event) bool {
+		return e.Event == CommandApplied || e.Event == CommandFailed
+	})
+	if e.Event != CommandApplied || e.Detail != "voltage=3.3" {
+		t.Errorf("command event = %+v, want %s voltage=3.3", e, CommandApplied)
+	}
+	if v := psu.voltage(); v != 3.3 {
+		t.Errorf("voltage level = %g, want 3.3", v)
+	}
+	await(t, msgs, "ivi/psu/P6V/voltage", func(r Reading) bool { return r.Value == 3.3 })
+
+	if err := client.Publish(ctx, "ivi/psu/P6V/set/voltage", false, []byte("high")); err != nil {
+		t.Fatal(err)
+	}
+	await(t, msgs, "ivi/psu/P6V/event", func(e Event) bool { return e.Event == CommandFailed })
+	if v := psu.voltage(); v != 3.3 {
+		t.Errorf("voltage level after invalid command = %g, want 3.3", v)
+	}
+}
+
+func TestOutputEvents(t *testing.T) {
+	psu := &fakePSU{volts: 5}
+	client, msgs := startBridge(t, psu)
+
+	await(t, msgs, "ivi/psu/P6V/voltage", func(Reading) bool { return true })
+	err := client.Publish(context.Background(), "ivi/psu/P6V/set/output", false, []byte("on"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	await(t, msgs, "ivi/psu/P6V/event", func(e Event) bool { return e.Event == OutputEnabled })
+	await(t, msgs, "ivi/psu/P6V/voltage", func(r Reading) bool { return r.Value == 5 })
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package mqttbridge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+)
+
+// Handler is called with the topic and payload of each message received on a
+// subscription.
+type Handler func(topic string, payload []byte)
+
+// Client is the MQTT client used by the bridge. PahoClient connects to an
+// external broker and Broker.Client uses an in-process broker.
+type Client interface {
+	Publish(ctx context.Context, topic string, retained bool, payload []byte) error
+	Subscribe(ctx context.Context, filter string, h Handler) error
+	Close() error
+}
+
+// PahoClient is a Client connected to an MQTT broker. It reconnects
+// automatically and restores its subscriptions after reconnecting.
+type PahoClient struct {
+	c paho.Client
+
+	mu   sync.Mutex
+	subs map[string]Handler
+}
+
+// NewPahoClient connects to the broker, e.g., "tcp://localhost:1883", using
+// QoS 1 for both publishing and subscribing.
+func NewPahoClient(ctx context.Context, broker, clientID string) (*PahoClient, error) {
+	pc := PahoClient{subs: make(map[string]Handler)}
+	opts := paho.NewClientOptions().
+		AddBroker(broker).
+		SetClientID(clientID).
+		SetAutoReconnect(true).
+		SetOrderMatters(false).
+		SetOnConnectHandler(pc.resubscribe)
+	pc.c = paho.NewClient(opts)
+	if err := wait(ctx, pc.c.Connect()); err != nil {
+		return nil, fmt.Errorf("error connecting to %s: %w", broker, err)
+	}
+	return &pc, nil
+}
+
+// wait waits for the token to complete or ctx to be done.
+func wait(ctx context.Context, t paho.Token) error {
+	select {
+	case <-t.Done():
+		return t.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Publish publishes the payload with QoS 1.
+func (pc *PahoClient) Publish(ctx context.Context, topic string, retained bool, payload []byte) error {
+	return wait(ctx, pc.c.Publish(topic, 1, retained, payload))
+}
+
+// Subscribe subscribes to the topic filter with QoS 1.
+func (pc *PahoClient) Subscribe(ctx context.Context, filter string, h Handler) error {
+	pc.mu.Lock()
+	pc.subs[filter] = h
+	pc.mu.Unlock()
+	return wait(ctx, pc.c.Subscribe(filter, 1, pahoHandler(h)))
+}
+
+func pahoHandler(h Handler) paho.MessageHandler {
+	return func(_ paho.Client, msg paho.Message) { h(msg.Topic(), msg.Payload()) }
+}
+
+// resubscribe restores the subscriptions when paho reconnects with a clean
+// session.
+func (pc *PahoClient) resubscribe(c paho.Client) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	for filter, h := range pc.subs {
+		c.Subscribe(filter, 1, pahoHandler(h))
+	}
+}
+
+// Close disconnects from the broker, allowing 250 ms for pending work.
+func (pc *PahoClient) Close() error {
+	pc.c.Disconnect(250)
+	return nil
+}
+
+// Broker is an in-process MQTT broker, which lets the bridge run without an
+// external broker and lets it be tested without a network.
+type Broker struct {
+	s *mochi.Server
+
+	mu    sync.Mutex
+	subID int
+}
+
+// NewBroker creates a broker that allows all clients. If addr isn't empty, the
+// broker also listens for TCP clients on it, e.g., ":1883".
+func NewBroker(addr string) (*Broker, error) {
+	s := mochi.New(&mochi.Options{
+		InlineClient: true,
+		Logger:       slog.New(slog.DiscardHandler),
+	})
+	if err := s.AddHook(new(auth.AllowHook), nil); err != nil {
+		return nil, err
+	}
+	if addr != "" {
+		tcp := listeners.NewTCP(listeners.Config{ID: "tcp", Address: addr})
+		if err := s.AddListener(tcp); err != nil {
+			return nil, fmt.Errorf("error listening on %s: %w", addr, err)
+		}
+	}
+	if err := s.Serve(); err != nil {
+		return nil, err
+	}
+	return &Broker{s: s}, nil
+}
+
+// Client returns a Client that publishes and subscribes directly in the
+// broker. Closing it doesn't close the broker.
+func (b *Broker) Client() Client { return inlineClient{b} }
+
+// Close stops the broker.
+func (b *Broker) Close() error { return b.s.Close() }
+
+type inlineClient struct{ b *Broker }
+
+func (c inlineClient) Publish(_ context.Context, topic string, retained bool, payload []byte) error {
+	return c.b.s.Publish(topic, payload, retained, 1)
+}
+
+func (c inlineClient) Subscribe(_ context.Context, filter string, h Handler) error {
+	c.b.mu.Lock()
+	c.b.subID++
+	id := c.b.subID
+	c.b.mu.Unlock()
+	return c.b.s.Subscribe(filter, id, func(_ *mochi.Client, _ packets.Subscription, pk packets.Packet) {
+		h(pk.TopicName, pk.Payload)
+	})
+}
+
+func (c inlineClient) Close() error { return nil }
@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package mqttbridge
+
+import (
+	"strings"
+	"time"
+)
+
+// Topics are the templates for the topics used by the bridge. The
+// placeholders {instrument}, {channel}, {quantity}, {event}, and {param} are
+// replaced by the instrument name from the bench config, the channel name,
+// and so on. Levels left empty, such as {channel} for a DMM, are dropped.
+type Topics struct {
+	Reading string
+	Event   string
+	Command string
+}
+
+// DefaultTopics are used for templates left empty.
+var DefaultTopics = Topics{
+	Reading: "ivi/{instrument}/{channel}/{quantity}",
+	Event:   "ivi/{instrument}/{channel}/event",
+	Command: "ivi/{instrument}/{channel}/set/{param}",
+}
+
+func (t Topics) withDefaults() Topics {
+	if t.Reading == "" {
+		t.Reading = DefaultTopics.Reading
+	}
+	if t.Event == "" {
+		t.Event = DefaultTopics.Event
+	}
+	if t.Command == "" {
+		t.Command = DefaultTopics.Command
+	}
+	return t
+}
+
+// expand replaces the placeholders in the template using the pairs of
+// placeholder names and values.
+func expand(template string, pairs ...string) string {
+	oldnew := make([]string, 0, len(pairs))
+	for i := 0; i+1 < len(pairs); i += 2 {
+		oldnew = append(oldnew, "{"+pairs[i]+"}", pairs[i+1])
+	}
+	levels := strings.Split(strings.NewReplacer(oldnew...).Replace(template), "/")
+	kept := levels[:0]
+	for _, level := range levels {
+		if level != "" {
+			kept = append(kept, level)
+		}
+	}
+	return strings.Join(kept, "/")
+}
+
+// Reading is the JSON payload published for a reading.
+type Reading struct {
+	Time       time.Time `json:"time"`
+	Instrument string    `json:"instrument"`
+	Model      string    `json:"model"`
+	Serial     string    `json:"serial"`
+	Channel    string    `json:"channel,omitempty"`
+	Quantity   string    `json:"quantity"`
+	Value      float64   `json:"value"`
+	Unit       string    `json:"unit,omitempty"`
+}
+
+// Event names.
+const (
+	OutputEnabled     = "output_enabled"
+	OutputDisabled    = "output_disabled"
+	OVPTrip           = "ovp_trip"
+	CurrentLimitTrip  = "current_limit_trip"
+	CommandApplied    = "command_applied"
+	CommandFailed     = "command_failed"
+	InstrumentFailure = "instrument_failure"
+)
+
+// Event is the JSON payload published for an event.
+type Event struct {
+	Time       time.Time `json:"time"`
+	Instrument string    `json:"instrument"`
+	Channel    string    `json:"channel,omitempty"`
+	Event      string    `json:"event"`
+	Detail     string    `json:"detail,omitempty"`
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package rack runs the setup steps of a bench of instruments concurrently.
+// Each step runs on one instrument in its own goroutine once the steps it
+// comes after have finished, so independent steps on different instruments
+// run in parallel while bench.Instrument.Do keeps the steps on any one
+// instrument from overlapping.
+//
+// A step that fails doesn't stop the others, but the steps that come after
+// it are skipped. Run returns the errors of all failed and skipped steps.
+package rack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+)
+
+// ErrSkipped is returned for a step that wasn't run because a step it comes
+// after failed.
+var ErrSkipped = errors.New("skipped")
+
+// Step is one operation on an instrument.
+type Step struct {
+	// Name identifies the step in After and in errors. Must be unique.
+	Name string
+	// Instrument is the name of the instrument in the bench.
+	Instrument string
+	// After lists the names of the steps that must finish first.
+	After []string
+	// Timeout bounds the wait for the instrument and the step. The driver
+	// calls themselves are bounded by the instrument's configured timeout,
+	// so Fn can only stop early between calls by checking ctx. Zero means no
+	// timeout other than that of the context given to Run.
+	Timeout time.Duration
+	// Fn is run with exclusive access to the instrument's driver.
+	Fn func(ctx context.Context, drv bench.Inherent) error
+}
+
+// Result is the outcome of one step.
+type Result struct {
+	Step       string
+	Instrument string
+	Start      time.Time
+	Duration   time.Duration
+	Err        error
+}
+
+// Runner runs steps on the instruments of a bench.
+type Runner struct {
+	bench  *bench.Bench
+	logger *log.Logger
+}
+
+// Option configures a Runner.
+type Option func(*Runner)
+
+// WithLogger sets the logger used to report each step as it finishes. The
+// default is the standard logger.
+func WithLogger(l *log.Logger) Option { return func(r *Runner) { r.logger = l } }
+
+// New creates a Runner using the instruments of b.
+func New(b *bench.Bench, opts ...Option) *Runner {
+	r := Runner{bench: b, logger: log.Default()}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// Run runs the steps and returns their results in the order given. The error
+// joins the errors of the steps that failed or were skipped. Steps still
+// waiting when ctx is done fail with ctx.Err(). Run returns an error without
+// running any step if a step names an unknown instrument or step, or if the
+// steps come after each other in a cycle.
+func (r *Runner) Run(ctx context.Context, steps []Step) ([]Result, error) {
+	insts, err := r.validate(steps)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		results = make([]Result, len(steps))
+		done    = make(map[string]chan struct{}, len(steps))
+		index   = make(map[string]int, len(steps))
+		wg      sync.WaitGroup
+	)
+	for i, s := range steps {
+		done[s.Name] = make(chan struct{})
+		index[s.Name] = i
+	}
+	for i, s := range steps {
+		wg.Go(func() {
+			defer close(done[s.Name])
+			res := &results[i]
+			res.Step, res.Instrument = s.Name, s.Instrument
+			for _, dep := range s.After {
+				select {
+				case <-done[dep]:
+				case <-ctx.Done():
+					res.Err = ctx.Err()
+					return
+				}
+				if results[index[dep]].Err != nil {
+					res.Err = fmt.Errorf("%w: %s didn't succeed", ErrSkipped, dep)
+					return
+				}
+			}
+			res.Start = time.Now()
+			res.Err = r.run(ctx, insts[i], s)
+			res.Duration = time.Since(res.Start)
+			if res.Err != nil {
+				r.logger.Printf("%s on %s failed after %s: %s", s.Name, s.Instrument, res.Duration, res.Err)
+				return
+			}
+			r.logger.Printf("%s on %s done in %s", s.Name, s.Instrument, res.Duration)
+		})
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, res := range results {
+		if res.Err != nil {
+			errs = append(errs, fmt.Errorf("step %s on %s: %w", res.Step, res.Instrument, res.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+func (r *Runner) run(ctx context.Context, inst *bench.Instrument, s Step) error {
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	return inst.Do(ctx, func(drv bench.Inherent) error { return s.Fn(ctx, drv) })
+}
+
+// validate looks up the instrument of each step and checks the names in
+// After, returning the instruments in step order.
+func (r *Runner) validate(steps []Step) ([]*bench.Instrument, error) {
+	var errs []error
+	insts := make([]*bench.Instrument, len(steps))
+	after := make(map[string][]string, len(steps))
+	for i, s := range steps {
+		switch _, dup := after[s.Name]; {
+		case s.Name == "":
+			errs = append(errs, fmt.Errorf("step %d: missing name", i))
+		case dup:
+			errs = append(errs, fmt.Errorf("step %q: duplicate name", s.Name))
+		}
+		after[s.Name] = s.After
+		inst, ok := r.bench.Instrument(s.Instrument)
+		if !ok {
+			errs = append(errs, fmt.Errorf("step %q: unknown instrument %q", s.Name, s.Instrument))
+		}
+		insts[i] = inst
+		if s.Fn == nil {
+			errs = append(errs, fmt.Errorf("step %q: missing function", s.Name))
+		}
+	}
+	for _, s := range steps {
+		for _, dep := range s.After {
+			if _, ok := after[dep]; !ok {
+				errs = append(errs, fmt.Errorf("step %q: unknown step %q", s.Name, dep))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if name, ok := cycle(after); ok {
+		return nil, fmt.Errorf("step %q comes after itself", name)
+	}
+	return insts, nil
+}
+
+// cycle reports whether any step comes after itself, returning its name.
+func cycle(after map[string][]string) (string, bool) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	var (
+		state = make(map[string]int, len(after))
+		found string
+		visit func(name string) bool
+	)
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			found = name
+			return true
+		case visited:
+			return false
+		}
+		state[name] = visiting
+		for _, dep := range after[name] {
+			if visit(dep) {
+				return true
+			}
+		}
+		state[name] = visited
+		return false
+	}
+	for name := range after {
+		if visit(name) {
+			return found, true
+		}
+	}
+	return "", false
+}
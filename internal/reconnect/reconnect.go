@@ -0,0 +1,373 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package reconnect provides an ivi.Transport that survives dropped
+// connections. When a socket is reset, a serial adapter is unplugged, or an
+// instrument is power cycled, the Device closes the dead connection and
+// reopens it using the original VISA address with exponential backoff. It can
+// optionally replay the configuration commands sent since the last *RST so an
+// instrument that lost its settings is returned to the same state.
+//
+// Because the Device is itself the transport handed to the IVI driver, the
+// driver keeps working across reconnects without being recreated.
+package reconnect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/visa"
+)
+
+// ErrClosed is returned by operations on a Device after Close.
+var ErrClosed = errors.New("reconnect: device closed")
+
+// maxJournal caps the number of configuration commands remembered for replay.
+const maxJournal = 1024
+
+// DialFunc opens a connection to the instrument at the VISA address.
+type DialFunc func(ctx context.Context, address string) (ivi.Transport, error)
+
+// dialVISA opens the address using the VISA drivers registered by the
+// program, e.g., by importing github.com/gotmc/visa/driver/tcpip.
+func dialVISA(ctx context.Context, address string) (ivi.Transport, error) {
+	return visa.NewResource(ctx, address)
+}
+
+// Device is an ivi.Transport that reconnects to the instrument when the
+// connection breaks. It is safe for concurrent use, with each operation
+// holding the connection exclusively.
+type Device struct {
+	address      string
+	dial         DialFunc
+	initial      time.Duration
+	max          time.Duration
+	maxAttempts  int
+	replay       bool
+	onDisconnect func(err error)
+	onReconnect  func(attempts int)
+
+	mu       sync.Mutex
+	conn     ivi.Transport // nil while disconnected
+	closed   bool
+	journal  []string
+	overflow bool
+	hooks    []func() // hook calls waiting for d.mu to be unlocked
+}
+
+// Option configures a Device.
+type Option func(*Device)
+
+// WithDialer sets the function used to open the connection. The default uses
+// visa.NewResource.
+func WithDialer(dial DialFunc) Option { return func(d *Device) { d.dial = dial } }
+
+// WithBackoff sets the delay before the second connection attempt, which then
+// doubles after each failed attempt up to max. The defaults are 250 ms and
+// 30 s.
+func WithBackoff(initial, max time.Duration) Option {
+	return func(d *Device) {
+		d.initial = initial
+		d.max = max
+	}
+}
+
+// WithMaxAttempts limits the number of connection attempts per reconnect. The
+// default of 0 keeps trying until the operation's context is done.
+func WithMaxAttempts(n int) Option { return func(d *Device) { d.maxAttempts = n } }
+
+// WithReplay remembers the commands sent since the last *RST and replays them
+// after reconnecting, re-applying the last known configuration.
+func WithReplay() Option { return func(d *Device) { d.replay = true } }
+
+// WithOnDisconnect sets a hook called with the error that broke the
+// connection.
+func WithOnDisconnect(fn func(err error)) Option {
+	return func(d *Device) { d.onDisconnect = fn }
+}
+
+// WithOnReconnect sets a hook called with the number of attempts it took once
+// the connection has been reopened and any replay has completed.
+//
+// Both hooks are called after the operation that ran into the disconnect or
+// reconnect releases the Device, so they may use it.
+func WithOnReconnect(fn func(attempts int)) Option {
+	return func(d *Device) { d.onReconnect = fn }
+}
+
+// New opens a connection to the instrument at the VISA address, retrying with
+// backoff until ctx is done or the maximum number of attempts is reached.
+func New(ctx context.Context, address string, opts ...Option) (*Device, error) {
+	d := Device{
+		address: address,
+		dial:    dialVISA,
+		initial: 250 * time.Millisecond,
+		max:     30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&d)
+	}
+	d.mu.Lock()
+	defer d.unlock()
+	if _, err := d.connect(ctx); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Address returns the VISA address of the instrument.
+func (d *Device) Address() string { return d.address }
+
+// Connected reports whether the Device currently holds an open connection.
+func (d *Device) Connected() bool {
+	d.mu.Lock()
+	defer d.unlock()
+	return d.conn != nil
+}
+
+// Command sends the command, reconnecting first if needed. If the connection
+// breaks while sending, it is reopened and the command sent once more.
+func (d *Device) Command(ctx context.Context, cmd string, a ...any) error {
+	if a != nil {
+		cmd = fmt.Sprintf(cmd, a...)
+	}
+	d.mu.Lock()
+	defer d.unlock()
+	err := d.do(ctx, true, func(conn ivi.Transport) error {
+		return conn.Command(ctx, cmd)
+	})
+	if err == nil {
+		d.record(cmd)
+	}
+	return err
+}
+
+// Query sends the query and returns the response, reconnecting first if
+// needed. If the connection breaks, it is reopened and the query sent once
+// more.
+func (d *Device) Query(ctx context.Context, cmd string) (string, error) {
+	d.mu.Lock()
+	defer d.unlock()
+	var resp string
+	err := d.do(ctx, true, func(conn ivi.Transport) error {
+		var err error
+		resp, err = conn.Query(ctx, cmd)
+		return err
+	})
+	return resp, err
+}
+
+// ReadBinary reads binary data. A broken connection is dropped, but the read
+// isn't retried since the rest of the response is lost with the connection.
+func (d *Device) ReadBinary(ctx context.Context, p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.unlock()
+	var n int
+	err := d.do(ctx, false, func(conn ivi.Transport) error {
+		var err error
+		n, err = conn.ReadBinary(ctx, p)
+		return err
+	})
+	return n, err
+}
+
+// WriteBinary writes binary data. A broken connection is dropped, but the
+// write isn't retried since a partial write can't be undone.
+func (d *Device) WriteBinary(ctx context.Context, p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.unlock()
+	var n int
+	err := d.do(ctx, false, func(conn ivi.Transport) error {
+		var err error
+		n, err = conn.WriteBinary(ctx, p)
+		return err
+	})
+	return n, err
+}
+
+// Close closes the connection. The Device can't be used afterwards.
+func (d *Device) Close() error {
+	d.mu.Lock()
+	defer d.unlock()
+	d.closed = true
+	if d.conn == nil {
+		return nil
+	}
+	err := d.conn.Close()
+	d.conn = nil
+	return err
+}
+
+// unlock unlocks d.mu and then calls the hooks queued while it was held, so
+// that a hook can use the Device.
+func (d *Device) unlock() {
+	hooks := d.hooks
+	d.hooks = nil
+	d.mu.Unlock()
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
+// do runs op on the connection, connecting first if needed. If op fails
+// because the connection broke, the connection is dropped and, if retry is
+// true and ctx hasn't expired, reopened and op run again. Must be called with
+// d.mu held.
+func (d *Device) do(ctx context.Context, retry bool, op func(ivi.Transport) error) error {
+	if d.closed {
+		return ErrClosed
+	}
+	if d.conn == nil {
+		if _, err := d.reconnect(ctx); err != nil {
+			return err
+		}
+	}
+	err := op(d.conn)
+	if err == nil || !IsBroken(err) {
+		return err
+	}
+	d.drop(err)
+	if !retry || ctx.Err() != nil {
+		return err
+	}
+	if _, rerr := d.reconnect(ctx); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	err = op(d.conn)
+	if err != nil && IsBroken(err) {
+		d.drop(err)
+	}
+	return err
+}
+
+// drop closes the broken connection and queues the disconnect hook. Must be
+// called with d.mu held.
+func (d *Device) drop(cause error) {
+	if d.conn == nil {
+		return
+	}
+	_ = d.conn.Close()
+	d.conn = nil
+	if d.onDisconnect != nil {
+		d.hooks = append(d.hooks, func() { d.onDisconnect(cause) })
+	}
+}
+
+// reconnect reopens the connection, replays the journal if enabled, and
+// queues the reconnect hook. Must be called with d.mu held.
+func (d *Device) reconnect(ctx context.Context) (int, error) {
+	attempts, err := d.connect(ctx)
+	if err != nil {
+		return attempts, err
+	}
+	if d.replay {
+		if err = d.replayJournal(ctx); err != nil {
+			d.drop(err)
+			return attempts, err
+		}
+	}
+	if d.onReconnect != nil {
+		d.hooks = append(d.hooks, func() { d.onReconnect(attempts) })
+	}
+	return attempts, nil
+}
+
+// connect dials the address with exponential backoff and returns the number
+// of attempts made. Must be called with d.mu held.
+func (d *Device) connect(ctx context.Context) (int, error) {
+	delay := d.initial
+	for attempt := 1; ; attempt++ {
+		conn, err := d.dial(ctx, d.address)
+		if err == nil {
+			d.conn = conn
+			return attempt, nil
+		}
+		if d.maxAttempts > 0 && attempt >= d.maxAttempts {
+			return attempt, fmt.Errorf("reconnect %s: giving up after %d attempts: %w",
+				d.address, attempt, err)
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, fmt.Errorf("reconnect %s: %w (last error: %w)",
+				d.address, ctx.Err(), err)
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, d.max)
+	}
+}
+
+// record adds a configuration command to the replay journal. Queries aren't
+// recorded and *RST starts a new journal since it discards all prior
+// configuration. Must be called with d.mu held.
+func (d *Device) record(cmd string) {
+	if !d.replay {
+		return
+	}
+	cmd = strings.TrimSpace(cmd)
+	if strings.EqualFold(cmd, "*RST") {
+		d.journal = d.journal[:0]
+		d.overflow = false
+	}
+	if d.overflow {
+		return
+	}
+	if len(d.journal) >= maxJournal {
+		d.overflow = true
+		return
+	}
+	d.journal = append(d.journal, cmd)
+}
+
+// replayJournal resends the journaled commands. Must be called with d.mu held.
+func (d *Device) replayJournal(ctx context.Context) error {
+	if d.overflow {
+		return fmt.Errorf("reconnect %s: more than %d commands since *RST, can't replay",
+			d.address, maxJournal)
+	}
+	for _, cmd := range d.journal {
+		if err := d.conn.Command(ctx, cmd); err != nil {
+			return fmt.Errorf("reconnect %s: error replaying %q: %w", d.address, cmd, err)
+		}
+	}
+	return nil
+}
+
+// IsBroken reports whether err indicates the connection to the instrument is
+// no longer usable: the peer closed or reset it, the device disappeared, or an
+// I/O timeout left an unread response in flight.
+func IsBroken(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	switch {
+	case errors.Is(err, io.EOF),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, io.ErrClosedPipe),
+		errors.Is(err, net.ErrClosed),
+		errors.Is(err, os.ErrClosed),
+		errors.Is(err, os.ErrDeadlineExceeded),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, syscall.ECONNRESET),
+		errors.Is(err, syscall.ECONNABORTED),
+		errors.Is(err, syscall.ECONNREFUSED),
+		errors.Is(err, syscall.EPIPE),
+		errors.Is(err, syscall.EIO),
+		errors.Is(err, syscall.ENXIO),
+		errors.Is(err, syscall.ENODEV),
+		errors.As(err, &netErr):
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package report
+
+import (
+	"html/template"
+	"io"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/measure"
+)
+
+// The HTML report is a single page with its styles inline, so it can be
+// archived or mailed as one file.
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"verdict":  measure.Verdict,
+	"lastLine": lastLine,
+	"time":     func(t time.Time) string { return t.Format("2006-01-02 15:04:05 MST") },
+	"round":    func(d time.Duration) time.Duration { return d.Round(time.Millisecond) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.7em; text-align: left; }
+th { background: #f0f0f0; }
+td.num { text-align: right; font-variant-numeric: tabular-nums; }
+.PASS { color: #fff; background: #2e7d32; font-weight: bold; }
+.FAIL { color: #fff; background: #c62828; font-weight: bold; }
+pre { background: #fbe9e7; padding: 0.7em; overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}} <span class="{{verdict .Passed}}">&nbsp;{{verdict .Passed}}&nbsp;</span></h1>
+<p>Started {{time .Start}}, ran for {{round .Duration}}.</p>
+{{- with .Instruments}}
+<h2>Instruments</h2>
+<table>
+<tr><th>Name</th><th>Manufacturer</th><th>Model</th><th>Serial number</th><th>Firmware</th><th>Driver</th><th>Address</th></tr>
+{{- range .}}
+<tr><td>{{.Name}}</td><td>{{.Manufacturer}}</td><td>{{.Model}}</td><td>{{.SerialNumber}}</td><td>{{.Firmware}}</td><td>{{.Driver}}</td><td>{{.Address}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Procedures}}
+<h2>{{.Name}} <span class="{{verdict .Passed}}">&nbsp;{{verdict .Passed}}&nbsp;</span></h2>
+<p>Started {{time .Start}}, {{len .Steps}} steps, {{.Failures}} failed in {{round .Duration}}.</p>
+{{- with .Steps}}
+<table>
+<tr><th>Step</th><th>Value</th><th>Limits</th><th>Verdict</th><th>Time</th></tr>
+{{- range .}}
+<tr><td>{{.Name}}{{with .Message}}<br><small>{{.}}</small>{{end}}</td><td class="num">{{.Measurement}}</td><td>{{.Limits}}</td><td class="{{verdict .Pass}}">{{verdict .Pass}}</td><td>{{time .Time}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- with .Err}}
+<p><b>Stopped: {{lastLine .Error}}</b></p>
+<pre>{{.Error}}</pre>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes the report as a self-contained HTML page.
+func (r *Report) WriteHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
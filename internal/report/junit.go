@@ -0,0 +1,139 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// The JUnit XML schema as understood by Jenkins, GitLab, and GitHub Actions.
+// Each procedure is a test suite and each step a test case. A procedure
+// stopped by an error gets an extra test case with the error.
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func seconds(d time.Duration) string { return fmt.Sprintf("%.3f", d.Seconds()) }
+
+// WriteJUnit writes the report as JUnit XML. The instrument identities are
+// added to every test suite as properties.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	var props []junitProperty
+	for _, id := range r.Instruments {
+		for _, p := range []junitProperty{
+			{"manufacturer", id.Manufacturer},
+			{"model", id.Model},
+			{"serialNumber", id.SerialNumber},
+			{"firmware", id.Firmware},
+			{"address", id.Address},
+		} {
+			props = append(props, junitProperty{id.Name + "." + p.Name, p.Value})
+		}
+	}
+
+	doc := junitTestSuites{Name: r.Title, Time: seconds(r.Duration())}
+	for _, p := range r.Procedures {
+		suite := junitTestSuite{
+			Name:       p.Name,
+			Tests:      len(p.Steps),
+			Failures:   p.Failures(),
+			Time:       seconds(p.Duration),
+			Timestamp:  p.Start.Format("2006-01-02T15:04:05"),
+			Properties: props,
+		}
+		durations := p.stepDurations()
+		for i, s := range p.Steps {
+			tc := junitTestCase{
+				Name:      s.Name,
+				ClassName: p.Name,
+				Time:      seconds(durations[i]),
+				SystemOut: s.Measurement().String(),
+			}
+			if !s.Pass {
+				msg := s.Message
+				if msg == "" {
+					msg = fmt.Sprintf("%s, limits %s", s.Measurement().String(), s.Limits())
+				}
+				tc.Failure = &junitMessage{Message: msg, Type: "LimitFailure", Text: s.Measurement().String()}
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		if p.Err != nil {
+			suite.Tests++
+			suite.Errors++
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      "procedure",
+				ClassName: p.Name,
+				Time:      "0.000",
+				Error:     &junitMessage{Message: lastLine(p.Err.Error()), Text: p.Err.Error()},
+			})
+		}
+		doc.Tests += suite.Tests
+		doc.Failures += suite.Failures
+		doc.Errors += suite.Errors
+		doc.Suites = append(doc.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// lastLine returns the last line of an error message, which is the error
+// itself for a message with a backtrace, such as that of a Starlark error.
+func lastLine(s string) string {
+	s = strings.TrimRight(s, "\n")
+	return s[strings.LastIndexByte(s, '\n')+1:]
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package report
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gotmc/ivi-examples/internal/measure"
+)
+
+// WriteFiles writes the report as HTML and as JUnit XML to the named files,
+// skipping either if its name is empty.
+func (r *Report) WriteFiles(html, junit string) error {
+	var errs []error
+	if html != "" {
+		errs = append(errs, writeFile(html, r.WriteHTML))
+	}
+	if junit != "" {
+		errs = append(errs, writeFile(junit, r.WriteJUnit))
+	}
+	return errors.Join(errs...)
+}
+
+// WriteSummary writes the errors that stopped procedures and the overall
+// verdict, as printed after a table of the steps.
+func (r *Report) WriteSummary(w io.Writer) {
+	for _, p := range r.Procedures {
+		if p.Err != nil {
+			fmt.Fprintf(w, "\nStopped: %s\n", p.Err)
+		}
+	}
+	fmt.Fprintf(w, "\nResult: %s\n\n", measure.Verdict(r.Passed()))
+}
+
+// Err returns the first error that stopped a procedure or, if every
+// procedure ran to the end, failed if a step failed. It returns nil if the
+// report passed.
+func (r *Report) Err(failed error) error {
+	for _, p := range r.Procedures {
+		if p.Err != nil {
+			return p.Err
+		}
+	}
+	if !r.Passed() {
+		return failed
+	}
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	return f.Close()
+}
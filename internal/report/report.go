@@ -0,0 +1,207 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package report collects the results of bench procedures, i.e., the steps
+// with their measured values, limits, and verdicts together with the
+// identities of the instruments used, and writes them as JUnit XML for CI
+// systems or as a self-contained HTML page.
+//
+// A report is built while the procedures run:
+//
+//	rep := report.New("Power-up verification")
+//	rep.Instruments, err = report.Identify(ctx, b)
+//	p := rep.Begin("rails")
+//	p.RecordResult(measure.Check("5 V rail", measure.Voltage(v), measure.Tolerance(5, 0.1)))
+//	p.End(err)
+//	err = rep.WriteFiles("report.html", "report.xml")
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+)
+
+// Identity identifies an instrument as reported by its IVI driver.
+type Identity struct {
+	Name         string `json:"name"`
+	Driver       string `json:"driver"`
+	Address      string `json:"address"`
+	Manufacturer string `json:"manufacturer"`
+	Model        string `json:"model"`
+	SerialNumber string `json:"serialNumber"`
+	Firmware     string `json:"firmware"`
+}
+
+// Identify queries the identity of every instrument of a bench. The
+// identities of instruments that fail to answer hold what could be queried.
+func Identify(ctx context.Context, b *bench.Bench) ([]Identity, error) {
+	var errs []error
+	ids := make([]Identity, 0, len(b.Instruments()))
+	for _, inst := range b.Instruments() {
+		id := Identity{Name: inst.Name, Driver: inst.Driver, Address: inst.Address}
+		err := inst.Do(ctx, func(drv bench.Inherent) error {
+			var errs []error
+			for _, q := range []struct {
+				dst  *string
+				fn   func() (string, error)
+				desc string
+			}{
+				{&id.Manufacturer, drv.InstrumentManufacturer, "manufacturer"},
+				{&id.Model, drv.InstrumentModel, "model"},
+				{&id.SerialNumber, drv.InstrumentSerialNumber, "serial number"},
+				{&id.Firmware, drv.FirmwareRevision, "firmware revision"},
+			} {
+				v, err := q.fn()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: error querying %s: %w", inst.Name, q.desc, err))
+					continue
+				}
+				*q.dst = v
+			}
+			return errors.Join(errs...)
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, errors.Join(errs...)
+}
+
+// Step is one measurement checked against its limits.
+type Step struct {
+	Name  string
+	Value float64
+	Min   *float64 // nil if there's no lower limit
+	Max   *float64 // nil if there's no upper limit
+	Unit  string
+	Pass  bool
+	Time  time.Time // when the step was recorded
+	// Message optionally explains the verdict.
+	Message string
+}
+
+// Limits returns the limits as text, e.g., "[4.9 V, 5.1 V]".
+func (s Step) Limits() string {
+	return measure.Limit{Min: s.Min, Max: s.Max}.Format(measure.Unit(s.Unit))
+}
+
+// Measurement returns the measured value with its unit.
+func (s Step) Measurement() measure.Measurement {
+	return measure.Measurement{Value: s.Value, Unit: measure.Unit(s.Unit)}
+}
+
+// Procedure is the result of running one procedure.
+type Procedure struct {
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+	Steps    []Step
+	// Err is the error that stopped the procedure before its end, if any.
+	Err error
+}
+
+// Record adds a step, setting its time if it's zero.
+func (p *Procedure) Record(s Step) {
+	if s.Time.IsZero() {
+		s.Time = time.Now()
+	}
+	p.Steps = append(p.Steps, s)
+}
+
+// RecordResult adds a step for the result of a measurement checked against
+// its limits. Limits given as a nominal value and tolerance are recorded as
+// the minimum and maximum.
+func (p *Procedure) RecordResult(r measure.Result) {
+	p.Record(Step{
+		Name:  r.Name,
+		Value: r.Value,
+		Min:   r.Limit.Min,
+		Max:   r.Limit.Max,
+		Unit:  string(r.Unit),
+		Pass:  r.Pass,
+		Time:  r.Time,
+	})
+}
+
+// End records the end of the procedure and the error that stopped it, if
+// any.
+func (p *Procedure) End(err error) {
+	p.Duration = time.Since(p.Start)
+	p.Err = err
+}
+
+// Failures returns the number of failed steps.
+func (p *Procedure) Failures() int {
+	n := 0
+	for _, s := range p.Steps {
+		if !s.Pass {
+			n++
+		}
+	}
+	return n
+}
+
+// Passed reports whether the procedure ran to the end with every step
+// passing.
+func (p *Procedure) Passed() bool { return p.Err == nil && p.Failures() == 0 }
+
+// stepDurations returns the time taken by each step, measured from the end
+// of the previous step or the start of the procedure.
+func (p *Procedure) stepDurations() []time.Duration {
+	d := make([]time.Duration, len(p.Steps))
+	prev := p.Start
+	for i, s := range p.Steps {
+		if !prev.IsZero() && s.Time.After(prev) {
+			d[i] = s.Time.Sub(prev)
+		}
+		prev = s.Time
+	}
+	return d
+}
+
+// Report is the result of a run of one or more procedures.
+type Report struct {
+	Title       string
+	Start       time.Time
+	Instruments []Identity
+	Procedures  []*Procedure
+}
+
+// New creates an empty report starting now.
+func New(title string) *Report {
+	return &Report{Title: title, Start: time.Now()}
+}
+
+// Begin starts a procedure and adds it to the report.
+func (r *Report) Begin(name string) *Procedure {
+	p := Procedure{Name: name, Start: time.Now()}
+	r.Procedures = append(r.Procedures, &p)
+	return &p
+}
+
+// Passed reports whether every procedure passed.
+func (r *Report) Passed() bool {
+	for _, p := range r.Procedures {
+		if !p.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Duration returns the total time taken by the procedures.
+func (r *Report) Duration() time.Duration {
+	var d time.Duration
+	for _, p := range r.Procedures {
+		d += p.Duration
+	}
+	return d
+}
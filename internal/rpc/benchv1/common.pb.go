@@ -0,0 +1,719 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/common.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InstrumentRef names an instrument in the bench configuration.
+type InstrumentRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstrumentRef) Reset() {
+	*x = InstrumentRef{}
+	mi := &file_bench_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstrumentRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstrumentRef) ProtoMessage() {}
+
+func (x *InstrumentRef) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstrumentRef.ProtoReflect.Descriptor instead.
+func (*InstrumentRef) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstrumentRef) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+// ChannelRef identifies a 0-based channel of an instrument.
+type ChannelRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Channel       int32                  `protobuf:"varint,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelRef) Reset() {
+	*x = ChannelRef{}
+	mi := &file_bench_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRef) ProtoMessage() {}
+
+func (x *ChannelRef) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRef.ProtoReflect.Descriptor instead.
+func (*ChannelRef) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChannelRef) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *ChannelRef) GetChannel() int32 {
+	if x != nil {
+		return x.Channel
+	}
+	return 0
+}
+
+// Instrument describes a configured instrument.
+type Instrument struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// IVI class: dcpwr, dmm, fgen, scope, or swtch.
+	Class         string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	Address       string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_bench_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instrument) ProtoMessage() {}
+
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Instrument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instrument) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Instrument) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Instrument) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type InstrumentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instruments   []*Instrument          `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstrumentList) Reset() {
+	*x = InstrumentList{}
+	mi := &file_bench_v1_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstrumentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstrumentList) ProtoMessage() {}
+
+func (x *InstrumentList) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstrumentList.ProtoReflect.Descriptor instead.
+func (*InstrumentList) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InstrumentList) GetInstruments() []*Instrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+type Identity struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer     string                 `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Model            string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	SerialNumber     string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	FirmwareRevision string                 `protobuf:"bytes,4,opt,name=firmware_revision,json=firmwareRevision,proto3" json:"firmware_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_bench_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Identity) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *Identity) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Identity) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Identity) GetFirmwareRevision() string {
+	if x != nil {
+		return x.FirmwareRevision
+	}
+	return ""
+}
+
+type ChannelList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Channel names indexed by the 0-based channel.
+	Channels      []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelList) Reset() {
+	*x = ChannelList{}
+	mi := &file_bench_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelList) ProtoMessage() {}
+
+func (x *ChannelList) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelList.ProtoReflect.Descriptor instead.
+func (*ChannelList) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChannelList) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// Enum carries an IVI enum such as dmm.MeasurementFunction. Responses set
+// both fields. In requests the name, as returned by the Go String method, is
+// used when set and the numeric value otherwise.
+type Enum struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enum) Reset() {
+	*x = Enum{}
+	mi := &file_bench_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enum) ProtoMessage() {}
+
+func (x *Enum) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enum.ProtoReflect.Descriptor instead.
+func (*Enum) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Enum) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Enum) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DoubleValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleValue) Reset() {
+	*x = DoubleValue{}
+	mi := &file_bench_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleValue) ProtoMessage() {}
+
+func (x *DoubleValue) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleValue.ProtoReflect.Descriptor instead.
+func (*DoubleValue) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DoubleValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type BoolValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoolValue) Reset() {
+	*x = BoolValue{}
+	mi := &file_bench_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoolValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolValue) ProtoMessage() {}
+
+func (x *BoolValue) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolValue.ProtoReflect.Descriptor instead.
+func (*BoolValue) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BoolValue) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type SetDoubleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDoubleRequest) Reset() {
+	*x = SetDoubleRequest{}
+	mi := &file_bench_v1_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDoubleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDoubleRequest) ProtoMessage() {}
+
+func (x *SetDoubleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDoubleRequest.ProtoReflect.Descriptor instead.
+func (*SetDoubleRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetDoubleRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SetDoubleRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SetBoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Value         bool                   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBoolRequest) Reset() {
+	*x = SetBoolRequest{}
+	mi := &file_bench_v1_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBoolRequest) ProtoMessage() {}
+
+func (x *SetBoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBoolRequest.ProtoReflect.Descriptor instead.
+func (*SetBoolRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetBoolRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SetBoolRequest) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+var File_bench_v1_common_proto protoreflect.FileDescriptor
+
+const file_bench_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x15bench/v1/common.proto\x12\bbench.v1\x1a\x1bgoogle/protobuf/empty.proto\"/\n" +
+	"\rInstrumentRef\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\"F\n" +
+	"\n" +
+	"ChannelRef\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\x05R\achannel\"h\n" +
+	"\n" +
+	"Instrument\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x14\n" +
+	"\x05class\x18\x03 \x01(\tR\x05class\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"H\n" +
+	"\x0eInstrumentList\x126\n" +
+	"\vinstruments\x18\x01 \x03(\v2\x14.bench.v1.InstrumentR\vinstruments\"\x96\x01\n" +
+	"\bIdentity\x12\"\n" +
+	"\fmanufacturer\x18\x01 \x01(\tR\fmanufacturer\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12+\n" +
+	"\x11firmware_revision\x18\x04 \x01(\tR\x10firmwareRevision\")\n" +
+	"\vChannelList\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\"0\n" +
+	"\x04Enum\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"#\n" +
+	"\vDoubleValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\"!\n" +
+	"\tBoolValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\"X\n" +
+	"\x10SetDoubleRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"V\n" +
+	"\x0eSetBoolRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value2\x85\x02\n" +
+	"\x11InstrumentService\x12C\n" +
+	"\x0fListInstruments\x12\x16.google.protobuf.Empty\x1a\x18.bench.v1.InstrumentList\x127\n" +
+	"\bIdentify\x12\x17.bench.v1.InstrumentRef\x1a\x12.bench.v1.Identity\x128\n" +
+	"\x05Reset\x12\x17.bench.v1.InstrumentRef\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\x05Clear\x12\x17.bench.v1.InstrumentRef\x1a\x16.google.protobuf.EmptyB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_common_proto_rawDescOnce sync.Once
+	file_bench_v1_common_proto_rawDescData []byte
+)
+
+func file_bench_v1_common_proto_rawDescGZIP() []byte {
+	file_bench_v1_common_proto_rawDescOnce.Do(func() {
+		file_bench_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_common_proto_rawDesc), len(file_bench_v1_common_proto_rawDesc)))
+	})
+	return file_bench_v1_common_proto_rawDescData
+}
+
+var file_bench_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_bench_v1_common_proto_goTypes = []any{
+	(*InstrumentRef)(nil),    // 0: bench.v1.InstrumentRef
+	(*ChannelRef)(nil),       // 1: bench.v1.ChannelRef
+	(*Instrument)(nil),       // 2: bench.v1.Instrument
+	(*InstrumentList)(nil),   // 3: bench.v1.InstrumentList
+	(*Identity)(nil),         // 4: bench.v1.Identity
+	(*ChannelList)(nil),      // 5: bench.v1.ChannelList
+	(*Enum)(nil),             // 6: bench.v1.Enum
+	(*DoubleValue)(nil),      // 7: bench.v1.DoubleValue
+	(*BoolValue)(nil),        // 8: bench.v1.BoolValue
+	(*SetDoubleRequest)(nil), // 9: bench.v1.SetDoubleRequest
+	(*SetBoolRequest)(nil),   // 10: bench.v1.SetBoolRequest
+	(*emptypb.Empty)(nil),    // 11: google.protobuf.Empty
+}
+var file_bench_v1_common_proto_depIdxs = []int32{
+	2,  // 0: bench.v1.InstrumentList.instruments:type_name -> bench.v1.Instrument
+	1,  // 1: bench.v1.SetDoubleRequest.channel:type_name -> bench.v1.ChannelRef
+	1,  // 2: bench.v1.SetBoolRequest.channel:type_name -> bench.v1.ChannelRef
+	11, // 3: bench.v1.InstrumentService.ListInstruments:input_type -> google.protobuf.Empty
+	0,  // 4: bench.v1.InstrumentService.Identify:input_type -> bench.v1.InstrumentRef
+	0,  // 5: bench.v1.InstrumentService.Reset:input_type -> bench.v1.InstrumentRef
+	0,  // 6: bench.v1.InstrumentService.Clear:input_type -> bench.v1.InstrumentRef
+	3,  // 7: bench.v1.InstrumentService.ListInstruments:output_type -> bench.v1.InstrumentList
+	4,  // 8: bench.v1.InstrumentService.Identify:output_type -> bench.v1.Identity
+	11, // 9: bench.v1.InstrumentService.Reset:output_type -> google.protobuf.Empty
+	11, // 10: bench.v1.InstrumentService.Clear:output_type -> google.protobuf.Empty
+	7,  // [7:11] is the sub-list for method output_type
+	3,  // [3:7] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_common_proto_init() }
+func file_bench_v1_common_proto_init() {
+	if File_bench_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_common_proto_rawDesc), len(file_bench_v1_common_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_common_proto_goTypes,
+		DependencyIndexes: file_bench_v1_common_proto_depIdxs,
+		MessageInfos:      file_bench_v1_common_proto_msgTypes,
+	}.Build()
+	File_bench_v1_common_proto = out.File
+	file_bench_v1_common_proto_goTypes = nil
+	file_bench_v1_common_proto_depIdxs = nil
+}
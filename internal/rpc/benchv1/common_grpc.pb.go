@@ -0,0 +1,255 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/common.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InstrumentService_ListInstruments_FullMethodName = "/bench.v1.InstrumentService/ListInstruments"
+	InstrumentService_Identify_FullMethodName        = "/bench.v1.InstrumentService/Identify"
+	InstrumentService_Reset_FullMethodName           = "/bench.v1.InstrumentService/Reset"
+	InstrumentService_Clear_FullMethodName           = "/bench.v1.InstrumentService/Clear"
+)
+
+// InstrumentServiceClient is the client API for InstrumentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InstrumentService lists the instruments on the bench and provides the IVI
+// inherent capabilities shared by all classes.
+type InstrumentServiceClient interface {
+	// ListInstruments returns the configured instruments.
+	ListInstruments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstrumentList, error)
+	// Identify queries the manufacturer, model, serial number, and firmware.
+	Identify(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*Identity, error)
+	// Reset resets the instrument to its default state.
+	Reset(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Clear clears the instrument's status.
+	Clear(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type instrumentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstrumentServiceClient(cc grpc.ClientConnInterface) InstrumentServiceClient {
+	return &instrumentServiceClient{cc}
+}
+
+func (c *instrumentServiceClient) ListInstruments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstrumentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstrumentList)
+	err := c.cc.Invoke(ctx, InstrumentService_ListInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Identify(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*Identity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Identity)
+	err := c.cc.Invoke(ctx, InstrumentService_Identify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Reset(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstrumentService_Reset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *instrumentServiceClient) Clear(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstrumentService_Clear_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstrumentServiceServer is the server API for InstrumentService service.
+// All implementations must embed UnimplementedInstrumentServiceServer
+// for forward compatibility.
+//
+// InstrumentService lists the instruments on the bench and provides the IVI
+// inherent capabilities shared by all classes.
+type InstrumentServiceServer interface {
+	// ListInstruments returns the configured instruments.
+	ListInstruments(context.Context, *emptypb.Empty) (*InstrumentList, error)
+	// Identify queries the manufacturer, model, serial number, and firmware.
+	Identify(context.Context, *InstrumentRef) (*Identity, error)
+	// Reset resets the instrument to its default state.
+	Reset(context.Context, *InstrumentRef) (*emptypb.Empty, error)
+	// Clear clears the instrument's status.
+	Clear(context.Context, *InstrumentRef) (*emptypb.Empty, error)
+	mustEmbedUnimplementedInstrumentServiceServer()
+}
+
+// UnimplementedInstrumentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInstrumentServiceServer struct{}
+
+func (UnimplementedInstrumentServiceServer) ListInstruments(context.Context, *emptypb.Empty) (*InstrumentList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInstruments not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Identify(context.Context, *InstrumentRef) (*Identity, error) {
+	return nil, status.Error(codes.Unimplemented, "method Identify not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Reset(context.Context, *InstrumentRef) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedInstrumentServiceServer) Clear(context.Context, *InstrumentRef) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Clear not implemented")
+}
+func (UnimplementedInstrumentServiceServer) mustEmbedUnimplementedInstrumentServiceServer() {}
+func (UnimplementedInstrumentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeInstrumentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstrumentServiceServer will
+// result in compilation errors.
+type UnsafeInstrumentServiceServer interface {
+	mustEmbedUnimplementedInstrumentServiceServer()
+}
+
+func RegisterInstrumentServiceServer(s grpc.ServiceRegistrar, srv InstrumentServiceServer) {
+	// If the following call panics, it indicates UnimplementedInstrumentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InstrumentService_ServiceDesc, srv)
+}
+
+func _InstrumentService_ListInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).ListInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstrumentService_ListInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).ListInstruments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Identify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstrumentService_Identify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Identify(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstrumentService_Reset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Reset(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstrumentService_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstrumentServiceServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstrumentService_Clear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstrumentServiceServer).Clear(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstrumentService_ServiceDesc is the grpc.ServiceDesc for InstrumentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstrumentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.InstrumentService",
+	HandlerType: (*InstrumentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInstruments",
+			Handler:    _InstrumentService_ListInstruments_Handler,
+		},
+		{
+			MethodName: "Identify",
+			Handler:    _InstrumentService_Identify_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _InstrumentService_Reset_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _InstrumentService_Clear_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/common.proto",
+}
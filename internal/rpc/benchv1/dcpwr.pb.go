@@ -0,0 +1,312 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/dcpwr.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OVPSettings are the over-voltage protection settings of an output.
+type OVPSettings struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Limit in volts.
+	Limit         float64 `protobuf:"fixed64,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OVPSettings) Reset() {
+	*x = OVPSettings{}
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OVPSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OVPSettings) ProtoMessage() {}
+
+func (x *OVPSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OVPSettings.ProtoReflect.Descriptor instead.
+func (*OVPSettings) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dcpwr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OVPSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *OVPSettings) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ConfigureOVPRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Limit in volts.
+	Limit         float64 `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureOVPRequest) Reset() {
+	*x = ConfigureOVPRequest{}
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureOVPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureOVPRequest) ProtoMessage() {}
+
+func (x *ConfigureOVPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureOVPRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureOVPRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dcpwr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigureOVPRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ConfigureOVPRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ConfigureOVPRequest) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryOutputStateRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// A dcpwr.OutputState.
+	State         *Enum `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryOutputStateRequest) Reset() {
+	*x = QueryOutputStateRequest{}
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryOutputStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryOutputStateRequest) ProtoMessage() {}
+
+func (x *QueryOutputStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dcpwr_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryOutputStateRequest.ProtoReflect.Descriptor instead.
+func (*QueryOutputStateRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dcpwr_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryOutputStateRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *QueryOutputStateRequest) GetState() *Enum {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+var File_bench_v1_dcpwr_proto protoreflect.FileDescriptor
+
+const file_bench_v1_dcpwr_proto_rawDesc = "" +
+	"\n" +
+	"\x14bench/v1/dcpwr.proto\x12\bbench.v1\x1a\x15bench/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"=\n" +
+	"\vOVPSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x01R\x05limit\"u\n" +
+	"\x13ConfigureOVPRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\"o\n" +
+	"\x17QueryOutputStateRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12$\n" +
+	"\x05state\x18\x02 \x01(\v2\x0e.bench.v1.EnumR\x05state2\xa9\x06\n" +
+	"\fDCPwrService\x12>\n" +
+	"\fListChannels\x12\x17.bench.v1.InstrumentRef\x1a\x15.bench.v1.ChannelList\x12>\n" +
+	"\x0fGetVoltageLevel\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12E\n" +
+	"\x0fSetVoltageLevel\x12\x1a.bench.v1.SetDoubleRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\x0fGetCurrentLimit\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12E\n" +
+	"\x0fSetCurrentLimit\x12\x1a.bench.v1.SetDoubleRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x10GetOutputEnabled\x12\x14.bench.v1.ChannelRef\x1a\x13.bench.v1.BoolValue\x12D\n" +
+	"\x10SetOutputEnabled\x12\x18.bench.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\x06GetOVP\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.OVPSettings\x12E\n" +
+	"\fConfigureOVP\x12\x1d.bench.v1.ConfigureOVPRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\x0eMeasureVoltage\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12=\n" +
+	"\x0eMeasureCurrent\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12J\n" +
+	"\x10QueryOutputState\x12!.bench.v1.QueryOutputStateRequest\x1a\x13.bench.v1.BoolValueB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_dcpwr_proto_rawDescOnce sync.Once
+	file_bench_v1_dcpwr_proto_rawDescData []byte
+)
+
+func file_bench_v1_dcpwr_proto_rawDescGZIP() []byte {
+	file_bench_v1_dcpwr_proto_rawDescOnce.Do(func() {
+		file_bench_v1_dcpwr_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_dcpwr_proto_rawDesc), len(file_bench_v1_dcpwr_proto_rawDesc)))
+	})
+	return file_bench_v1_dcpwr_proto_rawDescData
+}
+
+var file_bench_v1_dcpwr_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bench_v1_dcpwr_proto_goTypes = []any{
+	(*OVPSettings)(nil),             // 0: bench.v1.OVPSettings
+	(*ConfigureOVPRequest)(nil),     // 1: bench.v1.ConfigureOVPRequest
+	(*QueryOutputStateRequest)(nil), // 2: bench.v1.QueryOutputStateRequest
+	(*ChannelRef)(nil),              // 3: bench.v1.ChannelRef
+	(*Enum)(nil),                    // 4: bench.v1.Enum
+	(*InstrumentRef)(nil),           // 5: bench.v1.InstrumentRef
+	(*SetDoubleRequest)(nil),        // 6: bench.v1.SetDoubleRequest
+	(*SetBoolRequest)(nil),          // 7: bench.v1.SetBoolRequest
+	(*ChannelList)(nil),             // 8: bench.v1.ChannelList
+	(*DoubleValue)(nil),             // 9: bench.v1.DoubleValue
+	(*emptypb.Empty)(nil),           // 10: google.protobuf.Empty
+	(*BoolValue)(nil),               // 11: bench.v1.BoolValue
+}
+var file_bench_v1_dcpwr_proto_depIdxs = []int32{
+	3,  // 0: bench.v1.ConfigureOVPRequest.channel:type_name -> bench.v1.ChannelRef
+	3,  // 1: bench.v1.QueryOutputStateRequest.channel:type_name -> bench.v1.ChannelRef
+	4,  // 2: bench.v1.QueryOutputStateRequest.state:type_name -> bench.v1.Enum
+	5,  // 3: bench.v1.DCPwrService.ListChannels:input_type -> bench.v1.InstrumentRef
+	3,  // 4: bench.v1.DCPwrService.GetVoltageLevel:input_type -> bench.v1.ChannelRef
+	6,  // 5: bench.v1.DCPwrService.SetVoltageLevel:input_type -> bench.v1.SetDoubleRequest
+	3,  // 6: bench.v1.DCPwrService.GetCurrentLimit:input_type -> bench.v1.ChannelRef
+	6,  // 7: bench.v1.DCPwrService.SetCurrentLimit:input_type -> bench.v1.SetDoubleRequest
+	3,  // 8: bench.v1.DCPwrService.GetOutputEnabled:input_type -> bench.v1.ChannelRef
+	7,  // 9: bench.v1.DCPwrService.SetOutputEnabled:input_type -> bench.v1.SetBoolRequest
+	3,  // 10: bench.v1.DCPwrService.GetOVP:input_type -> bench.v1.ChannelRef
+	1,  // 11: bench.v1.DCPwrService.ConfigureOVP:input_type -> bench.v1.ConfigureOVPRequest
+	3,  // 12: bench.v1.DCPwrService.MeasureVoltage:input_type -> bench.v1.ChannelRef
+	3,  // 13: bench.v1.DCPwrService.MeasureCurrent:input_type -> bench.v1.ChannelRef
+	2,  // 14: bench.v1.DCPwrService.QueryOutputState:input_type -> bench.v1.QueryOutputStateRequest
+	8,  // 15: bench.v1.DCPwrService.ListChannels:output_type -> bench.v1.ChannelList
+	9,  // 16: bench.v1.DCPwrService.GetVoltageLevel:output_type -> bench.v1.DoubleValue
+	10, // 17: bench.v1.DCPwrService.SetVoltageLevel:output_type -> google.protobuf.Empty
+	9,  // 18: bench.v1.DCPwrService.GetCurrentLimit:output_type -> bench.v1.DoubleValue
+	10, // 19: bench.v1.DCPwrService.SetCurrentLimit:output_type -> google.protobuf.Empty
+	11, // 20: bench.v1.DCPwrService.GetOutputEnabled:output_type -> bench.v1.BoolValue
+	10, // 21: bench.v1.DCPwrService.SetOutputEnabled:output_type -> google.protobuf.Empty
+	0,  // 22: bench.v1.DCPwrService.GetOVP:output_type -> bench.v1.OVPSettings
+	10, // 23: bench.v1.DCPwrService.ConfigureOVP:output_type -> google.protobuf.Empty
+	9,  // 24: bench.v1.DCPwrService.MeasureVoltage:output_type -> bench.v1.DoubleValue
+	9,  // 25: bench.v1.DCPwrService.MeasureCurrent:output_type -> bench.v1.DoubleValue
+	11, // 26: bench.v1.DCPwrService.QueryOutputState:output_type -> bench.v1.BoolValue
+	15, // [15:27] is the sub-list for method output_type
+	3,  // [3:15] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_dcpwr_proto_init() }
+func file_bench_v1_dcpwr_proto_init() {
+	if File_bench_v1_dcpwr_proto != nil {
+		return
+	}
+	file_bench_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_dcpwr_proto_rawDesc), len(file_bench_v1_dcpwr_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_dcpwr_proto_goTypes,
+		DependencyIndexes: file_bench_v1_dcpwr_proto_depIdxs,
+		MessageInfos:      file_bench_v1_dcpwr_proto_msgTypes,
+	}.Build()
+	File_bench_v1_dcpwr_proto = out.File
+	file_bench_v1_dcpwr_proto_goTypes = nil
+	file_bench_v1_dcpwr_proto_depIdxs = nil
+}
@@ -0,0 +1,553 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/dcpwr.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DCPwrService_ListChannels_FullMethodName     = "/bench.v1.DCPwrService/ListChannels"
+	DCPwrService_GetVoltageLevel_FullMethodName  = "/bench.v1.DCPwrService/GetVoltageLevel"
+	DCPwrService_SetVoltageLevel_FullMethodName  = "/bench.v1.DCPwrService/SetVoltageLevel"
+	DCPwrService_GetCurrentLimit_FullMethodName  = "/bench.v1.DCPwrService/GetCurrentLimit"
+	DCPwrService_SetCurrentLimit_FullMethodName  = "/bench.v1.DCPwrService/SetCurrentLimit"
+	DCPwrService_GetOutputEnabled_FullMethodName = "/bench.v1.DCPwrService/GetOutputEnabled"
+	DCPwrService_SetOutputEnabled_FullMethodName = "/bench.v1.DCPwrService/SetOutputEnabled"
+	DCPwrService_GetOVP_FullMethodName           = "/bench.v1.DCPwrService/GetOVP"
+	DCPwrService_ConfigureOVP_FullMethodName     = "/bench.v1.DCPwrService/ConfigureOVP"
+	DCPwrService_MeasureVoltage_FullMethodName   = "/bench.v1.DCPwrService/MeasureVoltage"
+	DCPwrService_MeasureCurrent_FullMethodName   = "/bench.v1.DCPwrService/MeasureCurrent"
+	DCPwrService_QueryOutputState_FullMethodName = "/bench.v1.DCPwrService/QueryOutputState"
+)
+
+// DCPwrServiceClient is the client API for DCPwrService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DCPwrService controls DC power supplies.
+type DCPwrServiceClient interface {
+	ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error)
+	GetVoltageLevel(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	SetVoltageLevel(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCurrentLimit(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	SetCurrentLimit(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOutputEnabled(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*BoolValue, error)
+	SetOutputEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOVP(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*OVPSettings, error)
+	ConfigureOVP(ctx context.Context, in *ConfigureOVPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MeasureVoltage(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	MeasureCurrent(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	// QueryOutputState reports whether the output is in a state such as
+	// constant voltage or constant current.
+	QueryOutputState(ctx context.Context, in *QueryOutputStateRequest, opts ...grpc.CallOption) (*BoolValue, error)
+}
+
+type dCPwrServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDCPwrServiceClient(cc grpc.ClientConnInterface) DCPwrServiceClient {
+	return &dCPwrServiceClient{cc}
+}
+
+func (c *dCPwrServiceClient) ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelList)
+	err := c.cc.Invoke(ctx, DCPwrService_ListChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) GetVoltageLevel(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, DCPwrService_GetVoltageLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) SetVoltageLevel(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DCPwrService_SetVoltageLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) GetCurrentLimit(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, DCPwrService_GetCurrentLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) SetCurrentLimit(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DCPwrService_SetCurrentLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) GetOutputEnabled(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolValue)
+	err := c.cc.Invoke(ctx, DCPwrService_GetOutputEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) SetOutputEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DCPwrService_SetOutputEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) GetOVP(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*OVPSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OVPSettings)
+	err := c.cc.Invoke(ctx, DCPwrService_GetOVP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) ConfigureOVP(ctx context.Context, in *ConfigureOVPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DCPwrService_ConfigureOVP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) MeasureVoltage(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, DCPwrService_MeasureVoltage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) MeasureCurrent(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, DCPwrService_MeasureCurrent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dCPwrServiceClient) QueryOutputState(ctx context.Context, in *QueryOutputStateRequest, opts ...grpc.CallOption) (*BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolValue)
+	err := c.cc.Invoke(ctx, DCPwrService_QueryOutputState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DCPwrServiceServer is the server API for DCPwrService service.
+// All implementations must embed UnimplementedDCPwrServiceServer
+// for forward compatibility.
+//
+// DCPwrService controls DC power supplies.
+type DCPwrServiceServer interface {
+	ListChannels(context.Context, *InstrumentRef) (*ChannelList, error)
+	GetVoltageLevel(context.Context, *ChannelRef) (*DoubleValue, error)
+	SetVoltageLevel(context.Context, *SetDoubleRequest) (*emptypb.Empty, error)
+	GetCurrentLimit(context.Context, *ChannelRef) (*DoubleValue, error)
+	SetCurrentLimit(context.Context, *SetDoubleRequest) (*emptypb.Empty, error)
+	GetOutputEnabled(context.Context, *ChannelRef) (*BoolValue, error)
+	SetOutputEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	GetOVP(context.Context, *ChannelRef) (*OVPSettings, error)
+	ConfigureOVP(context.Context, *ConfigureOVPRequest) (*emptypb.Empty, error)
+	MeasureVoltage(context.Context, *ChannelRef) (*DoubleValue, error)
+	MeasureCurrent(context.Context, *ChannelRef) (*DoubleValue, error)
+	// QueryOutputState reports whether the output is in a state such as
+	// constant voltage or constant current.
+	QueryOutputState(context.Context, *QueryOutputStateRequest) (*BoolValue, error)
+	mustEmbedUnimplementedDCPwrServiceServer()
+}
+
+// UnimplementedDCPwrServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDCPwrServiceServer struct{}
+
+func (UnimplementedDCPwrServiceServer) ListChannels(context.Context, *InstrumentRef) (*ChannelList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedDCPwrServiceServer) GetVoltageLevel(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVoltageLevel not implemented")
+}
+func (UnimplementedDCPwrServiceServer) SetVoltageLevel(context.Context, *SetDoubleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVoltageLevel not implemented")
+}
+func (UnimplementedDCPwrServiceServer) GetCurrentLimit(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrentLimit not implemented")
+}
+func (UnimplementedDCPwrServiceServer) SetCurrentLimit(context.Context, *SetDoubleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCurrentLimit not implemented")
+}
+func (UnimplementedDCPwrServiceServer) GetOutputEnabled(context.Context, *ChannelRef) (*BoolValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOutputEnabled not implemented")
+}
+func (UnimplementedDCPwrServiceServer) SetOutputEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOutputEnabled not implemented")
+}
+func (UnimplementedDCPwrServiceServer) GetOVP(context.Context, *ChannelRef) (*OVPSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOVP not implemented")
+}
+func (UnimplementedDCPwrServiceServer) ConfigureOVP(context.Context, *ConfigureOVPRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureOVP not implemented")
+}
+func (UnimplementedDCPwrServiceServer) MeasureVoltage(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method MeasureVoltage not implemented")
+}
+func (UnimplementedDCPwrServiceServer) MeasureCurrent(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method MeasureCurrent not implemented")
+}
+func (UnimplementedDCPwrServiceServer) QueryOutputState(context.Context, *QueryOutputStateRequest) (*BoolValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryOutputState not implemented")
+}
+func (UnimplementedDCPwrServiceServer) mustEmbedUnimplementedDCPwrServiceServer() {}
+func (UnimplementedDCPwrServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeDCPwrServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DCPwrServiceServer will
+// result in compilation errors.
+type UnsafeDCPwrServiceServer interface {
+	mustEmbedUnimplementedDCPwrServiceServer()
+}
+
+func RegisterDCPwrServiceServer(s grpc.ServiceRegistrar, srv DCPwrServiceServer) {
+	// If the following call panics, it indicates UnimplementedDCPwrServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DCPwrService_ServiceDesc, srv)
+}
+
+func _DCPwrService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).ListChannels(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_GetVoltageLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).GetVoltageLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_GetVoltageLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).GetVoltageLevel(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_SetVoltageLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).SetVoltageLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_SetVoltageLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).SetVoltageLevel(ctx, req.(*SetDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_GetCurrentLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).GetCurrentLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_GetCurrentLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).GetCurrentLimit(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_SetCurrentLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).SetCurrentLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_SetCurrentLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).SetCurrentLimit(ctx, req.(*SetDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_GetOutputEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).GetOutputEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_GetOutputEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).GetOutputEnabled(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_SetOutputEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).SetOutputEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_SetOutputEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).SetOutputEnabled(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_GetOVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).GetOVP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_GetOVP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).GetOVP(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_ConfigureOVP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureOVPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).ConfigureOVP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_ConfigureOVP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).ConfigureOVP(ctx, req.(*ConfigureOVPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_MeasureVoltage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).MeasureVoltage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_MeasureVoltage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).MeasureVoltage(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_MeasureCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).MeasureCurrent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_MeasureCurrent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).MeasureCurrent(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DCPwrService_QueryOutputState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutputStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DCPwrServiceServer).QueryOutputState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DCPwrService_QueryOutputState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DCPwrServiceServer).QueryOutputState(ctx, req.(*QueryOutputStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DCPwrService_ServiceDesc is the grpc.ServiceDesc for DCPwrService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DCPwrService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.DCPwrService",
+	HandlerType: (*DCPwrServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChannels",
+			Handler:    _DCPwrService_ListChannels_Handler,
+		},
+		{
+			MethodName: "GetVoltageLevel",
+			Handler:    _DCPwrService_GetVoltageLevel_Handler,
+		},
+		{
+			MethodName: "SetVoltageLevel",
+			Handler:    _DCPwrService_SetVoltageLevel_Handler,
+		},
+		{
+			MethodName: "GetCurrentLimit",
+			Handler:    _DCPwrService_GetCurrentLimit_Handler,
+		},
+		{
+			MethodName: "SetCurrentLimit",
+			Handler:    _DCPwrService_SetCurrentLimit_Handler,
+		},
+		{
+			MethodName: "GetOutputEnabled",
+			Handler:    _DCPwrService_GetOutputEnabled_Handler,
+		},
+		{
+			MethodName: "SetOutputEnabled",
+			Handler:    _DCPwrService_SetOutputEnabled_Handler,
+		},
+		{
+			MethodName: "GetOVP",
+			Handler:    _DCPwrService_GetOVP_Handler,
+		},
+		{
+			MethodName: "ConfigureOVP",
+			Handler:    _DCPwrService_ConfigureOVP_Handler,
+		},
+		{
+			MethodName: "MeasureVoltage",
+			Handler:    _DCPwrService_MeasureVoltage_Handler,
+		},
+		{
+			MethodName: "MeasureCurrent",
+			Handler:    _DCPwrService_MeasureCurrent_Handler,
+		},
+		{
+			MethodName: "QueryOutputState",
+			Handler:    _DCPwrService_QueryOutputState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/dcpwr.proto",
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/dmm.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetMeasurementFunctionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Instrument string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	// A dmm.MeasurementFunction.
+	Function      *Enum `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMeasurementFunctionRequest) Reset() {
+	*x = SetMeasurementFunctionRequest{}
+	mi := &file_bench_v1_dmm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMeasurementFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeasurementFunctionRequest) ProtoMessage() {}
+
+func (x *SetMeasurementFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dmm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeasurementFunctionRequest.ProtoReflect.Descriptor instead.
+func (*SetMeasurementFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dmm_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetMeasurementFunctionRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *SetMeasurementFunctionRequest) GetFunction() *Enum {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+type RangeSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A dmm.AutoRange.
+	AutoRange *Enum `protobuf:"bytes,1,opt,name=auto_range,json=autoRange,proto3" json:"auto_range,omitempty"`
+	// Manual range in the units of the measurement function.
+	Range         float64 `protobuf:"fixed64,2,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeSettings) Reset() {
+	*x = RangeSettings{}
+	mi := &file_bench_v1_dmm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeSettings) ProtoMessage() {}
+
+func (x *RangeSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dmm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeSettings.ProtoReflect.Descriptor instead.
+func (*RangeSettings) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dmm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RangeSettings) GetAutoRange() *Enum {
+	if x != nil {
+		return x.AutoRange
+	}
+	return nil
+}
+
+func (x *RangeSettings) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+type SetRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Settings      *RangeSettings         `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRangeRequest) Reset() {
+	*x = SetRangeRequest{}
+	mi := &file_bench_v1_dmm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRangeRequest) ProtoMessage() {}
+
+func (x *SetRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dmm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRangeRequest.ProtoReflect.Descriptor instead.
+func (*SetRangeRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dmm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetRangeRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *SetRangeRequest) GetSettings() *RangeSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ReadMeasurementRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Instrument string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	// Maximum time to wait for the measurement. Defaults to 1 s.
+	MaxTime       *durationpb.Duration `protobuf:"bytes,2,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMeasurementRequest) Reset() {
+	*x = ReadMeasurementRequest{}
+	mi := &file_bench_v1_dmm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMeasurementRequest) ProtoMessage() {}
+
+func (x *ReadMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_dmm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*ReadMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_dmm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadMeasurementRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *ReadMeasurementRequest) GetMaxTime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTime
+	}
+	return nil
+}
+
+var File_bench_v1_dmm_proto protoreflect.FileDescriptor
+
+const file_bench_v1_dmm_proto_rawDesc = "" +
+	"\n" +
+	"\x12bench/v1/dmm.proto\x12\bbench.v1\x1a\x15bench/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"k\n" +
+	"\x1dSetMeasurementFunctionRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x12*\n" +
+	"\bfunction\x18\x02 \x01(\v2\x0e.bench.v1.EnumR\bfunction\"T\n" +
+	"\rRangeSettings\x12-\n" +
+	"\n" +
+	"auto_range\x18\x01 \x01(\v2\x0e.bench.v1.EnumR\tautoRange\x12\x14\n" +
+	"\x05range\x18\x02 \x01(\x01R\x05range\"f\n" +
+	"\x0fSetRangeRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x123\n" +
+	"\bsettings\x18\x02 \x01(\v2\x17.bench.v1.RangeSettingsR\bsettings\"n\n" +
+	"\x16ReadMeasurementRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x124\n" +
+	"\bmax_time\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\amaxTime2\xf3\x02\n" +
+	"\n" +
+	"DMMService\x12A\n" +
+	"\x16GetMeasurementFunction\x12\x17.bench.v1.InstrumentRef\x1a\x0e.bench.v1.Enum\x12Y\n" +
+	"\x16SetMeasurementFunction\x12'.bench.v1.SetMeasurementFunctionRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\bGetRange\x12\x17.bench.v1.InstrumentRef\x1a\x17.bench.v1.RangeSettings\x12=\n" +
+	"\bSetRange\x12\x19.bench.v1.SetRangeRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x0fReadMeasurement\x12 .bench.v1.ReadMeasurementRequest\x1a\x15.bench.v1.DoubleValueB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_dmm_proto_rawDescOnce sync.Once
+	file_bench_v1_dmm_proto_rawDescData []byte
+)
+
+func file_bench_v1_dmm_proto_rawDescGZIP() []byte {
+	file_bench_v1_dmm_proto_rawDescOnce.Do(func() {
+		file_bench_v1_dmm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_dmm_proto_rawDesc), len(file_bench_v1_dmm_proto_rawDesc)))
+	})
+	return file_bench_v1_dmm_proto_rawDescData
+}
+
+var file_bench_v1_dmm_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_bench_v1_dmm_proto_goTypes = []any{
+	(*SetMeasurementFunctionRequest)(nil), // 0: bench.v1.SetMeasurementFunctionRequest
+	(*RangeSettings)(nil),                 // 1: bench.v1.RangeSettings
+	(*SetRangeRequest)(nil),               // 2: bench.v1.SetRangeRequest
+	(*ReadMeasurementRequest)(nil),        // 3: bench.v1.ReadMeasurementRequest
+	(*Enum)(nil),                          // 4: bench.v1.Enum
+	(*durationpb.Duration)(nil),           // 5: google.protobuf.Duration
+	(*InstrumentRef)(nil),                 // 6: bench.v1.InstrumentRef
+	(*emptypb.Empty)(nil),                 // 7: google.protobuf.Empty
+	(*DoubleValue)(nil),                   // 8: bench.v1.DoubleValue
+}
+var file_bench_v1_dmm_proto_depIdxs = []int32{
+	4, // 0: bench.v1.SetMeasurementFunctionRequest.function:type_name -> bench.v1.Enum
+	4, // 1: bench.v1.RangeSettings.auto_range:type_name -> bench.v1.Enum
+	1, // 2: bench.v1.SetRangeRequest.settings:type_name -> bench.v1.RangeSettings
+	5, // 3: bench.v1.ReadMeasurementRequest.max_time:type_name -> google.protobuf.Duration
+	6, // 4: bench.v1.DMMService.GetMeasurementFunction:input_type -> bench.v1.InstrumentRef
+	0, // 5: bench.v1.DMMService.SetMeasurementFunction:input_type -> bench.v1.SetMeasurementFunctionRequest
+	6, // 6: bench.v1.DMMService.GetRange:input_type -> bench.v1.InstrumentRef
+	2, // 7: bench.v1.DMMService.SetRange:input_type -> bench.v1.SetRangeRequest
+	3, // 8: bench.v1.DMMService.ReadMeasurement:input_type -> bench.v1.ReadMeasurementRequest
+	4, // 9: bench.v1.DMMService.GetMeasurementFunction:output_type -> bench.v1.Enum
+	7, // 10: bench.v1.DMMService.SetMeasurementFunction:output_type -> google.protobuf.Empty
+	1, // 11: bench.v1.DMMService.GetRange:output_type -> bench.v1.RangeSettings
+	7, // 12: bench.v1.DMMService.SetRange:output_type -> google.protobuf.Empty
+	8, // 13: bench.v1.DMMService.ReadMeasurement:output_type -> bench.v1.DoubleValue
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_dmm_proto_init() }
+func file_bench_v1_dmm_proto_init() {
+	if File_bench_v1_dmm_proto != nil {
+		return
+	}
+	file_bench_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_dmm_proto_rawDesc), len(file_bench_v1_dmm_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_dmm_proto_goTypes,
+		DependencyIndexes: file_bench_v1_dmm_proto_depIdxs,
+		MessageInfos:      file_bench_v1_dmm_proto_msgTypes,
+	}.Build()
+	File_bench_v1_dmm_proto = out.File
+	file_bench_v1_dmm_proto_goTypes = nil
+	file_bench_v1_dmm_proto_depIdxs = nil
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/dmm.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DMMService_GetMeasurementFunction_FullMethodName = "/bench.v1.DMMService/GetMeasurementFunction"
+	DMMService_SetMeasurementFunction_FullMethodName = "/bench.v1.DMMService/SetMeasurementFunction"
+	DMMService_GetRange_FullMethodName               = "/bench.v1.DMMService/GetRange"
+	DMMService_SetRange_FullMethodName               = "/bench.v1.DMMService/SetRange"
+	DMMService_ReadMeasurement_FullMethodName        = "/bench.v1.DMMService/ReadMeasurement"
+)
+
+// DMMServiceClient is the client API for DMMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DMMService controls digital multimeters.
+type DMMServiceClient interface {
+	GetMeasurementFunction(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*Enum, error)
+	SetMeasurementFunction(ctx context.Context, in *SetMeasurementFunctionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetRange(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*RangeSettings, error)
+	SetRange(ctx context.Context, in *SetRangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReadMeasurement initiates a measurement and returns the reading.
+	ReadMeasurement(ctx context.Context, in *ReadMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error)
+}
+
+type dMMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDMMServiceClient(cc grpc.ClientConnInterface) DMMServiceClient {
+	return &dMMServiceClient{cc}
+}
+
+func (c *dMMServiceClient) GetMeasurementFunction(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*Enum, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enum)
+	err := c.cc.Invoke(ctx, DMMService_GetMeasurementFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMMServiceClient) SetMeasurementFunction(ctx context.Context, in *SetMeasurementFunctionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DMMService_SetMeasurementFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMMServiceClient) GetRange(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*RangeSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RangeSettings)
+	err := c.cc.Invoke(ctx, DMMService_GetRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMMServiceClient) SetRange(ctx context.Context, in *SetRangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DMMService_SetRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMMServiceClient) ReadMeasurement(ctx context.Context, in *ReadMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, DMMService_ReadMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DMMServiceServer is the server API for DMMService service.
+// All implementations must embed UnimplementedDMMServiceServer
+// for forward compatibility.
+//
+// DMMService controls digital multimeters.
+type DMMServiceServer interface {
+	GetMeasurementFunction(context.Context, *InstrumentRef) (*Enum, error)
+	SetMeasurementFunction(context.Context, *SetMeasurementFunctionRequest) (*emptypb.Empty, error)
+	GetRange(context.Context, *InstrumentRef) (*RangeSettings, error)
+	SetRange(context.Context, *SetRangeRequest) (*emptypb.Empty, error)
+	// ReadMeasurement initiates a measurement and returns the reading.
+	ReadMeasurement(context.Context, *ReadMeasurementRequest) (*DoubleValue, error)
+	mustEmbedUnimplementedDMMServiceServer()
+}
+
+// UnimplementedDMMServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDMMServiceServer struct{}
+
+func (UnimplementedDMMServiceServer) GetMeasurementFunction(context.Context, *InstrumentRef) (*Enum, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMeasurementFunction not implemented")
+}
+func (UnimplementedDMMServiceServer) SetMeasurementFunction(context.Context, *SetMeasurementFunctionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMeasurementFunction not implemented")
+}
+func (UnimplementedDMMServiceServer) GetRange(context.Context, *InstrumentRef) (*RangeSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRange not implemented")
+}
+func (UnimplementedDMMServiceServer) SetRange(context.Context, *SetRangeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRange not implemented")
+}
+func (UnimplementedDMMServiceServer) ReadMeasurement(context.Context, *ReadMeasurementRequest) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReadMeasurement not implemented")
+}
+func (UnimplementedDMMServiceServer) mustEmbedUnimplementedDMMServiceServer() {}
+func (UnimplementedDMMServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeDMMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DMMServiceServer will
+// result in compilation errors.
+type UnsafeDMMServiceServer interface {
+	mustEmbedUnimplementedDMMServiceServer()
+}
+
+func RegisterDMMServiceServer(s grpc.ServiceRegistrar, srv DMMServiceServer) {
+	// If the following call panics, it indicates UnimplementedDMMServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DMMService_ServiceDesc, srv)
+}
+
+func _DMMService_GetMeasurementFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMMServiceServer).GetMeasurementFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMMService_GetMeasurementFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMMServiceServer).GetMeasurementFunction(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMMService_SetMeasurementFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeasurementFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMMServiceServer).SetMeasurementFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMMService_SetMeasurementFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMMServiceServer).SetMeasurementFunction(ctx, req.(*SetMeasurementFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMMService_GetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMMServiceServer).GetRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMMService_GetRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMMServiceServer).GetRange(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMMService_SetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMMServiceServer).SetRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMMService_SetRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMMServiceServer).SetRange(ctx, req.(*SetRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMMService_ReadMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMMServiceServer).ReadMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMMService_ReadMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMMServiceServer).ReadMeasurement(ctx, req.(*ReadMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DMMService_ServiceDesc is the grpc.ServiceDesc for DMMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DMMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.DMMService",
+	HandlerType: (*DMMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMeasurementFunction",
+			Handler:    _DMMService_GetMeasurementFunction_Handler,
+		},
+		{
+			MethodName: "SetMeasurementFunction",
+			Handler:    _DMMService_SetMeasurementFunction_Handler,
+		},
+		{
+			MethodName: "GetRange",
+			Handler:    _DMMService_GetRange_Handler,
+		},
+		{
+			MethodName: "SetRange",
+			Handler:    _DMMService_SetRange_Handler,
+		},
+		{
+			MethodName: "ReadMeasurement",
+			Handler:    _DMMService_ReadMeasurement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/dmm.proto",
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/fgen.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigureStandardWaveformRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// An fgen.StandardWaveform.
+	Waveform *Enum `protobuf:"bytes,2,opt,name=waveform,proto3" json:"waveform,omitempty"`
+	// Amplitude in Vpp.
+	Amplitude float64 `protobuf:"fixed64,3,opt,name=amplitude,proto3" json:"amplitude,omitempty"`
+	// DC offset in V.
+	DcOffset float64 `protobuf:"fixed64,4,opt,name=dc_offset,json=dcOffset,proto3" json:"dc_offset,omitempty"`
+	// Frequency in Hz.
+	Frequency float64 `protobuf:"fixed64,5,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	// Start phase in degrees.
+	StartPhase    float64 `protobuf:"fixed64,6,opt,name=start_phase,json=startPhase,proto3" json:"start_phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureStandardWaveformRequest) Reset() {
+	*x = ConfigureStandardWaveformRequest{}
+	mi := &file_bench_v1_fgen_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureStandardWaveformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureStandardWaveformRequest) ProtoMessage() {}
+
+func (x *ConfigureStandardWaveformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_fgen_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureStandardWaveformRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureStandardWaveformRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_fgen_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigureStandardWaveformRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ConfigureStandardWaveformRequest) GetWaveform() *Enum {
+	if x != nil {
+		return x.Waveform
+	}
+	return nil
+}
+
+func (x *ConfigureStandardWaveformRequest) GetAmplitude() float64 {
+	if x != nil {
+		return x.Amplitude
+	}
+	return 0
+}
+
+func (x *ConfigureStandardWaveformRequest) GetDcOffset() float64 {
+	if x != nil {
+		return x.DcOffset
+	}
+	return 0
+}
+
+func (x *ConfigureStandardWaveformRequest) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *ConfigureStandardWaveformRequest) GetStartPhase() float64 {
+	if x != nil {
+		return x.StartPhase
+	}
+	return 0
+}
+
+type SetStandardWaveformRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// An fgen.StandardWaveform.
+	Waveform      *Enum `protobuf:"bytes,2,opt,name=waveform,proto3" json:"waveform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStandardWaveformRequest) Reset() {
+	*x = SetStandardWaveformRequest{}
+	mi := &file_bench_v1_fgen_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStandardWaveformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStandardWaveformRequest) ProtoMessage() {}
+
+func (x *SetStandardWaveformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_fgen_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStandardWaveformRequest.ProtoReflect.Descriptor instead.
+func (*SetStandardWaveformRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_fgen_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetStandardWaveformRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SetStandardWaveformRequest) GetWaveform() *Enum {
+	if x != nil {
+		return x.Waveform
+	}
+	return nil
+}
+
+var File_bench_v1_fgen_proto protoreflect.FileDescriptor
+
+const file_bench_v1_fgen_proto_rawDesc = "" +
+	"\n" +
+	"\x13bench/v1/fgen.proto\x12\bbench.v1\x1a\x15bench/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xf8\x01\n" +
+	" ConfigureStandardWaveformRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12*\n" +
+	"\bwaveform\x18\x02 \x01(\v2\x0e.bench.v1.EnumR\bwaveform\x12\x1c\n" +
+	"\tamplitude\x18\x03 \x01(\x01R\tamplitude\x12\x1b\n" +
+	"\tdc_offset\x18\x04 \x01(\x01R\bdcOffset\x12\x1c\n" +
+	"\tfrequency\x18\x05 \x01(\x01R\tfrequency\x12\x1f\n" +
+	"\vstart_phase\x18\x06 \x01(\x01R\n" +
+	"startPhase\"x\n" +
+	"\x1aSetStandardWaveformRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12*\n" +
+	"\bwaveform\x18\x02 \x01(\v2\x0e.bench.v1.EnumR\bwaveform2\x84\a\n" +
+	"\vFGenService\x12>\n" +
+	"\fListChannels\x12\x17.bench.v1.InstrumentRef\x1a\x15.bench.v1.ChannelList\x12_\n" +
+	"\x19ConfigureStandardWaveform\x12*.bench.v1.ConfigureStandardWaveformRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\x13GetStandardWaveform\x12\x14.bench.v1.ChannelRef\x1a\x0e.bench.v1.Enum\x12S\n" +
+	"\x13SetStandardWaveform\x12$.bench.v1.SetStandardWaveformRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fGetFrequency\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12B\n" +
+	"\fSetFrequency\x12\x1a.bench.v1.SetDoubleRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fGetAmplitude\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12B\n" +
+	"\fSetAmplitude\x12\x1a.bench.v1.SetDoubleRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\vGetDCOffset\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12A\n" +
+	"\vSetDCOffset\x12\x1a.bench.v1.SetDoubleRequest\x1a\x16.google.protobuf.Empty\x12<\n" +
+	"\rGetStartPhase\x12\x14.bench.v1.ChannelRef\x1a\x15.bench.v1.DoubleValue\x12=\n" +
+	"\x10GetOutputEnabled\x12\x14.bench.v1.ChannelRef\x1a\x13.bench.v1.BoolValue\x12D\n" +
+	"\x10SetOutputEnabled\x12\x18.bench.v1.SetBoolRequest\x1a\x16.google.protobuf.EmptyB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_fgen_proto_rawDescOnce sync.Once
+	file_bench_v1_fgen_proto_rawDescData []byte
+)
+
+func file_bench_v1_fgen_proto_rawDescGZIP() []byte {
+	file_bench_v1_fgen_proto_rawDescOnce.Do(func() {
+		file_bench_v1_fgen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_fgen_proto_rawDesc), len(file_bench_v1_fgen_proto_rawDesc)))
+	})
+	return file_bench_v1_fgen_proto_rawDescData
+}
+
+var file_bench_v1_fgen_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bench_v1_fgen_proto_goTypes = []any{
+	(*ConfigureStandardWaveformRequest)(nil), // 0: bench.v1.ConfigureStandardWaveformRequest
+	(*SetStandardWaveformRequest)(nil),       // 1: bench.v1.SetStandardWaveformRequest
+	(*ChannelRef)(nil),                       // 2: bench.v1.ChannelRef
+	(*Enum)(nil),                             // 3: bench.v1.Enum
+	(*InstrumentRef)(nil),                    // 4: bench.v1.InstrumentRef
+	(*SetDoubleRequest)(nil),                 // 5: bench.v1.SetDoubleRequest
+	(*SetBoolRequest)(nil),                   // 6: bench.v1.SetBoolRequest
+	(*ChannelList)(nil),                      // 7: bench.v1.ChannelList
+	(*emptypb.Empty)(nil),                    // 8: google.protobuf.Empty
+	(*DoubleValue)(nil),                      // 9: bench.v1.DoubleValue
+	(*BoolValue)(nil),                        // 10: bench.v1.BoolValue
+}
+var file_bench_v1_fgen_proto_depIdxs = []int32{
+	2,  // 0: bench.v1.ConfigureStandardWaveformRequest.channel:type_name -> bench.v1.ChannelRef
+	3,  // 1: bench.v1.ConfigureStandardWaveformRequest.waveform:type_name -> bench.v1.Enum
+	2,  // 2: bench.v1.SetStandardWaveformRequest.channel:type_name -> bench.v1.ChannelRef
+	3,  // 3: bench.v1.SetStandardWaveformRequest.waveform:type_name -> bench.v1.Enum
+	4,  // 4: bench.v1.FGenService.ListChannels:input_type -> bench.v1.InstrumentRef
+	0,  // 5: bench.v1.FGenService.ConfigureStandardWaveform:input_type -> bench.v1.ConfigureStandardWaveformRequest
+	2,  // 6: bench.v1.FGenService.GetStandardWaveform:input_type -> bench.v1.ChannelRef
+	1,  // 7: bench.v1.FGenService.SetStandardWaveform:input_type -> bench.v1.SetStandardWaveformRequest
+	2,  // 8: bench.v1.FGenService.GetFrequency:input_type -> bench.v1.ChannelRef
+	5,  // 9: bench.v1.FGenService.SetFrequency:input_type -> bench.v1.SetDoubleRequest
+	2,  // 10: bench.v1.FGenService.GetAmplitude:input_type -> bench.v1.ChannelRef
+	5,  // 11: bench.v1.FGenService.SetAmplitude:input_type -> bench.v1.SetDoubleRequest
+	2,  // 12: bench.v1.FGenService.GetDCOffset:input_type -> bench.v1.ChannelRef
+	5,  // 13: bench.v1.FGenService.SetDCOffset:input_type -> bench.v1.SetDoubleRequest
+	2,  // 14: bench.v1.FGenService.GetStartPhase:input_type -> bench.v1.ChannelRef
+	2,  // 15: bench.v1.FGenService.GetOutputEnabled:input_type -> bench.v1.ChannelRef
+	6,  // 16: bench.v1.FGenService.SetOutputEnabled:input_type -> bench.v1.SetBoolRequest
+	7,  // 17: bench.v1.FGenService.ListChannels:output_type -> bench.v1.ChannelList
+	8,  // 18: bench.v1.FGenService.ConfigureStandardWaveform:output_type -> google.protobuf.Empty
+	3,  // 19: bench.v1.FGenService.GetStandardWaveform:output_type -> bench.v1.Enum
+	8,  // 20: bench.v1.FGenService.SetStandardWaveform:output_type -> google.protobuf.Empty
+	9,  // 21: bench.v1.FGenService.GetFrequency:output_type -> bench.v1.DoubleValue
+	8,  // 22: bench.v1.FGenService.SetFrequency:output_type -> google.protobuf.Empty
+	9,  // 23: bench.v1.FGenService.GetAmplitude:output_type -> bench.v1.DoubleValue
+	8,  // 24: bench.v1.FGenService.SetAmplitude:output_type -> google.protobuf.Empty
+	9,  // 25: bench.v1.FGenService.GetDCOffset:output_type -> bench.v1.DoubleValue
+	8,  // 26: bench.v1.FGenService.SetDCOffset:output_type -> google.protobuf.Empty
+	9,  // 27: bench.v1.FGenService.GetStartPhase:output_type -> bench.v1.DoubleValue
+	10, // 28: bench.v1.FGenService.GetOutputEnabled:output_type -> bench.v1.BoolValue
+	8,  // 29: bench.v1.FGenService.SetOutputEnabled:output_type -> google.protobuf.Empty
+	17, // [17:30] is the sub-list for method output_type
+	4,  // [4:17] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_fgen_proto_init() }
+func file_bench_v1_fgen_proto_init() {
+	if File_bench_v1_fgen_proto != nil {
+		return
+	}
+	file_bench_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_fgen_proto_rawDesc), len(file_bench_v1_fgen_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_fgen_proto_goTypes,
+		DependencyIndexes: file_bench_v1_fgen_proto_depIdxs,
+		MessageInfos:      file_bench_v1_fgen_proto_msgTypes,
+	}.Build()
+	File_bench_v1_fgen_proto = out.File
+	file_bench_v1_fgen_proto_goTypes = nil
+	file_bench_v1_fgen_proto_depIdxs = nil
+}
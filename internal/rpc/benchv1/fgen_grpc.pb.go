@@ -0,0 +1,591 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/fgen.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FGenService_ListChannels_FullMethodName              = "/bench.v1.FGenService/ListChannels"
+	FGenService_ConfigureStandardWaveform_FullMethodName = "/bench.v1.FGenService/ConfigureStandardWaveform"
+	FGenService_GetStandardWaveform_FullMethodName       = "/bench.v1.FGenService/GetStandardWaveform"
+	FGenService_SetStandardWaveform_FullMethodName       = "/bench.v1.FGenService/SetStandardWaveform"
+	FGenService_GetFrequency_FullMethodName              = "/bench.v1.FGenService/GetFrequency"
+	FGenService_SetFrequency_FullMethodName              = "/bench.v1.FGenService/SetFrequency"
+	FGenService_GetAmplitude_FullMethodName              = "/bench.v1.FGenService/GetAmplitude"
+	FGenService_SetAmplitude_FullMethodName              = "/bench.v1.FGenService/SetAmplitude"
+	FGenService_GetDCOffset_FullMethodName               = "/bench.v1.FGenService/GetDCOffset"
+	FGenService_SetDCOffset_FullMethodName               = "/bench.v1.FGenService/SetDCOffset"
+	FGenService_GetStartPhase_FullMethodName             = "/bench.v1.FGenService/GetStartPhase"
+	FGenService_GetOutputEnabled_FullMethodName          = "/bench.v1.FGenService/GetOutputEnabled"
+	FGenService_SetOutputEnabled_FullMethodName          = "/bench.v1.FGenService/SetOutputEnabled"
+)
+
+// FGenServiceClient is the client API for FGenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FGenService controls function generators.
+type FGenServiceClient interface {
+	ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error)
+	// ConfigureStandardWaveform sets the waveform, amplitude, DC offset,
+	// frequency, and start phase in one call.
+	ConfigureStandardWaveform(ctx context.Context, in *ConfigureStandardWaveformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetStandardWaveform(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*Enum, error)
+	SetStandardWaveform(ctx context.Context, in *SetStandardWaveformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFrequency(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	SetFrequency(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAmplitude(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	SetAmplitude(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetDCOffset(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	SetDCOffset(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetStartPhase(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error)
+	GetOutputEnabled(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*BoolValue, error)
+	SetOutputEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type fGenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFGenServiceClient(cc grpc.ClientConnInterface) FGenServiceClient {
+	return &fGenServiceClient{cc}
+}
+
+func (c *fGenServiceClient) ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelList)
+	err := c.cc.Invoke(ctx, FGenService_ListChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) ConfigureStandardWaveform(ctx context.Context, in *ConfigureStandardWaveformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_ConfigureStandardWaveform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetStandardWaveform(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*Enum, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Enum)
+	err := c.cc.Invoke(ctx, FGenService_GetStandardWaveform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) SetStandardWaveform(ctx context.Context, in *SetStandardWaveformRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_SetStandardWaveform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetFrequency(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, FGenService_GetFrequency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) SetFrequency(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_SetFrequency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetAmplitude(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, FGenService_GetAmplitude_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) SetAmplitude(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_SetAmplitude_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetDCOffset(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, FGenService_GetDCOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) SetDCOffset(ctx context.Context, in *SetDoubleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_SetDCOffset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetStartPhase(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, FGenService_GetStartPhase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) GetOutputEnabled(ctx context.Context, in *ChannelRef, opts ...grpc.CallOption) (*BoolValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolValue)
+	err := c.cc.Invoke(ctx, FGenService_GetOutputEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fGenServiceClient) SetOutputEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FGenService_SetOutputEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FGenServiceServer is the server API for FGenService service.
+// All implementations must embed UnimplementedFGenServiceServer
+// for forward compatibility.
+//
+// FGenService controls function generators.
+type FGenServiceServer interface {
+	ListChannels(context.Context, *InstrumentRef) (*ChannelList, error)
+	// ConfigureStandardWaveform sets the waveform, amplitude, DC offset,
+	// frequency, and start phase in one call.
+	ConfigureStandardWaveform(context.Context, *ConfigureStandardWaveformRequest) (*emptypb.Empty, error)
+	GetStandardWaveform(context.Context, *ChannelRef) (*Enum, error)
+	SetStandardWaveform(context.Context, *SetStandardWaveformRequest) (*emptypb.Empty, error)
+	GetFrequency(context.Context, *ChannelRef) (*DoubleValue, error)
+	SetFrequency(context.Context, *SetDoubleRequest) (*emptypb.Empty, error)
+	GetAmplitude(context.Context, *ChannelRef) (*DoubleValue, error)
+	SetAmplitude(context.Context, *SetDoubleRequest) (*emptypb.Empty, error)
+	GetDCOffset(context.Context, *ChannelRef) (*DoubleValue, error)
+	SetDCOffset(context.Context, *SetDoubleRequest) (*emptypb.Empty, error)
+	GetStartPhase(context.Context, *ChannelRef) (*DoubleValue, error)
+	GetOutputEnabled(context.Context, *ChannelRef) (*BoolValue, error)
+	SetOutputEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFGenServiceServer()
+}
+
+// UnimplementedFGenServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFGenServiceServer struct{}
+
+func (UnimplementedFGenServiceServer) ListChannels(context.Context, *InstrumentRef) (*ChannelList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedFGenServiceServer) ConfigureStandardWaveform(context.Context, *ConfigureStandardWaveformRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureStandardWaveform not implemented")
+}
+func (UnimplementedFGenServiceServer) GetStandardWaveform(context.Context, *ChannelRef) (*Enum, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStandardWaveform not implemented")
+}
+func (UnimplementedFGenServiceServer) SetStandardWaveform(context.Context, *SetStandardWaveformRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStandardWaveform not implemented")
+}
+func (UnimplementedFGenServiceServer) GetFrequency(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFrequency not implemented")
+}
+func (UnimplementedFGenServiceServer) SetFrequency(context.Context, *SetDoubleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFrequency not implemented")
+}
+func (UnimplementedFGenServiceServer) GetAmplitude(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAmplitude not implemented")
+}
+func (UnimplementedFGenServiceServer) SetAmplitude(context.Context, *SetDoubleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAmplitude not implemented")
+}
+func (UnimplementedFGenServiceServer) GetDCOffset(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDCOffset not implemented")
+}
+func (UnimplementedFGenServiceServer) SetDCOffset(context.Context, *SetDoubleRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDCOffset not implemented")
+}
+func (UnimplementedFGenServiceServer) GetStartPhase(context.Context, *ChannelRef) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStartPhase not implemented")
+}
+func (UnimplementedFGenServiceServer) GetOutputEnabled(context.Context, *ChannelRef) (*BoolValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOutputEnabled not implemented")
+}
+func (UnimplementedFGenServiceServer) SetOutputEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOutputEnabled not implemented")
+}
+func (UnimplementedFGenServiceServer) mustEmbedUnimplementedFGenServiceServer() {}
+func (UnimplementedFGenServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeFGenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FGenServiceServer will
+// result in compilation errors.
+type UnsafeFGenServiceServer interface {
+	mustEmbedUnimplementedFGenServiceServer()
+}
+
+func RegisterFGenServiceServer(s grpc.ServiceRegistrar, srv FGenServiceServer) {
+	// If the following call panics, it indicates UnimplementedFGenServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FGenService_ServiceDesc, srv)
+}
+
+func _FGenService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).ListChannels(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_ConfigureStandardWaveform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureStandardWaveformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).ConfigureStandardWaveform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_ConfigureStandardWaveform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).ConfigureStandardWaveform(ctx, req.(*ConfigureStandardWaveformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetStandardWaveform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetStandardWaveform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetStandardWaveform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetStandardWaveform(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_SetStandardWaveform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStandardWaveformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).SetStandardWaveform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_SetStandardWaveform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).SetStandardWaveform(ctx, req.(*SetStandardWaveformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetFrequency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetFrequency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetFrequency(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_SetFrequency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).SetFrequency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_SetFrequency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).SetFrequency(ctx, req.(*SetDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetAmplitude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetAmplitude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetAmplitude_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetAmplitude(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_SetAmplitude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).SetAmplitude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_SetAmplitude_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).SetAmplitude(ctx, req.(*SetDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetDCOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetDCOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetDCOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetDCOffset(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_SetDCOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDoubleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).SetDCOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_SetDCOffset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).SetDCOffset(ctx, req.(*SetDoubleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetStartPhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetStartPhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetStartPhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetStartPhase(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_GetOutputEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).GetOutputEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_GetOutputEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).GetOutputEnabled(ctx, req.(*ChannelRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FGenService_SetOutputEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FGenServiceServer).SetOutputEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FGenService_SetOutputEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FGenServiceServer).SetOutputEnabled(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FGenService_ServiceDesc is the grpc.ServiceDesc for FGenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FGenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.FGenService",
+	HandlerType: (*FGenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChannels",
+			Handler:    _FGenService_ListChannels_Handler,
+		},
+		{
+			MethodName: "ConfigureStandardWaveform",
+			Handler:    _FGenService_ConfigureStandardWaveform_Handler,
+		},
+		{
+			MethodName: "GetStandardWaveform",
+			Handler:    _FGenService_GetStandardWaveform_Handler,
+		},
+		{
+			MethodName: "SetStandardWaveform",
+			Handler:    _FGenService_SetStandardWaveform_Handler,
+		},
+		{
+			MethodName: "GetFrequency",
+			Handler:    _FGenService_GetFrequency_Handler,
+		},
+		{
+			MethodName: "SetFrequency",
+			Handler:    _FGenService_SetFrequency_Handler,
+		},
+		{
+			MethodName: "GetAmplitude",
+			Handler:    _FGenService_GetAmplitude_Handler,
+		},
+		{
+			MethodName: "SetAmplitude",
+			Handler:    _FGenService_SetAmplitude_Handler,
+		},
+		{
+			MethodName: "GetDCOffset",
+			Handler:    _FGenService_GetDCOffset_Handler,
+		},
+		{
+			MethodName: "SetDCOffset",
+			Handler:    _FGenService_SetDCOffset_Handler,
+		},
+		{
+			MethodName: "GetStartPhase",
+			Handler:    _FGenService_GetStartPhase_Handler,
+		},
+		{
+			MethodName: "GetOutputEnabled",
+			Handler:    _FGenService_GetOutputEnabled_Handler,
+		},
+		{
+			MethodName: "SetOutputEnabled",
+			Handler:    _FGenService_SetOutputEnabled_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/fgen.proto",
+}
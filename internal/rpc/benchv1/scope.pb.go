@@ -0,0 +1,175 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/scope.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WaveformMeasurementRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *ChannelRef            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// A scope.MeasFunction.
+	Function *Enum `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	// Maximum time to wait for an acquisition. Only used by
+	// ReadWaveformMeasurement, where it defaults to 1 s.
+	MaxTime       *durationpb.Duration `protobuf:"bytes,3,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaveformMeasurementRequest) Reset() {
+	*x = WaveformMeasurementRequest{}
+	mi := &file_bench_v1_scope_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaveformMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaveformMeasurementRequest) ProtoMessage() {}
+
+func (x *WaveformMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_scope_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaveformMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*WaveformMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_scope_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WaveformMeasurementRequest) GetChannel() *ChannelRef {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *WaveformMeasurementRequest) GetFunction() *Enum {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+func (x *WaveformMeasurementRequest) GetMaxTime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTime
+	}
+	return nil
+}
+
+var File_bench_v1_scope_proto protoreflect.FileDescriptor
+
+const file_bench_v1_scope_proto_rawDesc = "" +
+	"\n" +
+	"\x14bench/v1/scope.proto\x12\bbench.v1\x1a\x15bench/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xae\x01\n" +
+	"\x1aWaveformMeasurementRequest\x12.\n" +
+	"\achannel\x18\x01 \x01(\v2\x14.bench.v1.ChannelRefR\achannel\x12*\n" +
+	"\bfunction\x18\x02 \x01(\v2\x0e.bench.v1.EnumR\bfunction\x124\n" +
+	"\bmax_time\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\amaxTime2\xc6\x02\n" +
+	"\fScopeService\x12>\n" +
+	"\fListChannels\x12\x17.bench.v1.InstrumentRef\x1a\x15.bench.v1.ChannelList\x12E\n" +
+	"\x11SetChannelEnabled\x12\x18.bench.v1.SetBoolRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x18FetchWaveformMeasurement\x12$.bench.v1.WaveformMeasurementRequest\x1a\x15.bench.v1.DoubleValue\x12V\n" +
+	"\x17ReadWaveformMeasurement\x12$.bench.v1.WaveformMeasurementRequest\x1a\x15.bench.v1.DoubleValueB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_scope_proto_rawDescOnce sync.Once
+	file_bench_v1_scope_proto_rawDescData []byte
+)
+
+func file_bench_v1_scope_proto_rawDescGZIP() []byte {
+	file_bench_v1_scope_proto_rawDescOnce.Do(func() {
+		file_bench_v1_scope_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_scope_proto_rawDesc), len(file_bench_v1_scope_proto_rawDesc)))
+	})
+	return file_bench_v1_scope_proto_rawDescData
+}
+
+var file_bench_v1_scope_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_bench_v1_scope_proto_goTypes = []any{
+	(*WaveformMeasurementRequest)(nil), // 0: bench.v1.WaveformMeasurementRequest
+	(*ChannelRef)(nil),                 // 1: bench.v1.ChannelRef
+	(*Enum)(nil),                       // 2: bench.v1.Enum
+	(*durationpb.Duration)(nil),        // 3: google.protobuf.Duration
+	(*InstrumentRef)(nil),              // 4: bench.v1.InstrumentRef
+	(*SetBoolRequest)(nil),             // 5: bench.v1.SetBoolRequest
+	(*ChannelList)(nil),                // 6: bench.v1.ChannelList
+	(*emptypb.Empty)(nil),              // 7: google.protobuf.Empty
+	(*DoubleValue)(nil),                // 8: bench.v1.DoubleValue
+}
+var file_bench_v1_scope_proto_depIdxs = []int32{
+	1, // 0: bench.v1.WaveformMeasurementRequest.channel:type_name -> bench.v1.ChannelRef
+	2, // 1: bench.v1.WaveformMeasurementRequest.function:type_name -> bench.v1.Enum
+	3, // 2: bench.v1.WaveformMeasurementRequest.max_time:type_name -> google.protobuf.Duration
+	4, // 3: bench.v1.ScopeService.ListChannels:input_type -> bench.v1.InstrumentRef
+	5, // 4: bench.v1.ScopeService.SetChannelEnabled:input_type -> bench.v1.SetBoolRequest
+	0, // 5: bench.v1.ScopeService.FetchWaveformMeasurement:input_type -> bench.v1.WaveformMeasurementRequest
+	0, // 6: bench.v1.ScopeService.ReadWaveformMeasurement:input_type -> bench.v1.WaveformMeasurementRequest
+	6, // 7: bench.v1.ScopeService.ListChannels:output_type -> bench.v1.ChannelList
+	7, // 8: bench.v1.ScopeService.SetChannelEnabled:output_type -> google.protobuf.Empty
+	8, // 9: bench.v1.ScopeService.FetchWaveformMeasurement:output_type -> bench.v1.DoubleValue
+	8, // 10: bench.v1.ScopeService.ReadWaveformMeasurement:output_type -> bench.v1.DoubleValue
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_scope_proto_init() }
+func file_bench_v1_scope_proto_init() {
+	if File_bench_v1_scope_proto != nil {
+		return
+	}
+	file_bench_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_scope_proto_rawDesc), len(file_bench_v1_scope_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_scope_proto_goTypes,
+		DependencyIndexes: file_bench_v1_scope_proto_depIdxs,
+		MessageInfos:      file_bench_v1_scope_proto_msgTypes,
+	}.Build()
+	File_bench_v1_scope_proto = out.File
+	file_bench_v1_scope_proto_goTypes = nil
+	file_bench_v1_scope_proto_depIdxs = nil
+}
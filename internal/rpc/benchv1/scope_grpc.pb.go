@@ -0,0 +1,249 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/scope.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScopeService_ListChannels_FullMethodName             = "/bench.v1.ScopeService/ListChannels"
+	ScopeService_SetChannelEnabled_FullMethodName        = "/bench.v1.ScopeService/SetChannelEnabled"
+	ScopeService_FetchWaveformMeasurement_FullMethodName = "/bench.v1.ScopeService/FetchWaveformMeasurement"
+	ScopeService_ReadWaveformMeasurement_FullMethodName  = "/bench.v1.ScopeService/ReadWaveformMeasurement"
+)
+
+// ScopeServiceClient is the client API for ScopeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ScopeService controls oscilloscopes.
+type ScopeServiceClient interface {
+	ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error)
+	SetChannelEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// FetchWaveformMeasurement measures the waveform already acquired.
+	FetchWaveformMeasurement(ctx context.Context, in *WaveformMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error)
+	// ReadWaveformMeasurement acquires a new waveform and measures it.
+	ReadWaveformMeasurement(ctx context.Context, in *WaveformMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error)
+}
+
+type scopeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScopeServiceClient(cc grpc.ClientConnInterface) ScopeServiceClient {
+	return &scopeServiceClient{cc}
+}
+
+func (c *scopeServiceClient) ListChannels(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelList)
+	err := c.cc.Invoke(ctx, ScopeService_ListChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) SetChannelEnabled(ctx context.Context, in *SetBoolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ScopeService_SetChannelEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) FetchWaveformMeasurement(ctx context.Context, in *WaveformMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, ScopeService_FetchWaveformMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) ReadWaveformMeasurement(ctx context.Context, in *WaveformMeasurementRequest, opts ...grpc.CallOption) (*DoubleValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoubleValue)
+	err := c.cc.Invoke(ctx, ScopeService_ReadWaveformMeasurement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScopeServiceServer is the server API for ScopeService service.
+// All implementations must embed UnimplementedScopeServiceServer
+// for forward compatibility.
+//
+// ScopeService controls oscilloscopes.
+type ScopeServiceServer interface {
+	ListChannels(context.Context, *InstrumentRef) (*ChannelList, error)
+	SetChannelEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error)
+	// FetchWaveformMeasurement measures the waveform already acquired.
+	FetchWaveformMeasurement(context.Context, *WaveformMeasurementRequest) (*DoubleValue, error)
+	// ReadWaveformMeasurement acquires a new waveform and measures it.
+	ReadWaveformMeasurement(context.Context, *WaveformMeasurementRequest) (*DoubleValue, error)
+	mustEmbedUnimplementedScopeServiceServer()
+}
+
+// UnimplementedScopeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScopeServiceServer struct{}
+
+func (UnimplementedScopeServiceServer) ListChannels(context.Context, *InstrumentRef) (*ChannelList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedScopeServiceServer) SetChannelEnabled(context.Context, *SetBoolRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannelEnabled not implemented")
+}
+func (UnimplementedScopeServiceServer) FetchWaveformMeasurement(context.Context, *WaveformMeasurementRequest) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method FetchWaveformMeasurement not implemented")
+}
+func (UnimplementedScopeServiceServer) ReadWaveformMeasurement(context.Context, *WaveformMeasurementRequest) (*DoubleValue, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReadWaveformMeasurement not implemented")
+}
+func (UnimplementedScopeServiceServer) mustEmbedUnimplementedScopeServiceServer() {}
+func (UnimplementedScopeServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeScopeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScopeServiceServer will
+// result in compilation errors.
+type UnsafeScopeServiceServer interface {
+	mustEmbedUnimplementedScopeServiceServer()
+}
+
+func RegisterScopeServiceServer(s grpc.ServiceRegistrar, srv ScopeServiceServer) {
+	// If the following call panics, it indicates UnimplementedScopeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScopeService_ServiceDesc, srv)
+}
+
+func _ScopeService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ListChannels(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_SetChannelEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).SetChannelEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_SetChannelEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).SetChannelEnabled(ctx, req.(*SetBoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_FetchWaveformMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaveformMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).FetchWaveformMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_FetchWaveformMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).FetchWaveformMeasurement(ctx, req.(*WaveformMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_ReadWaveformMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaveformMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).ReadWaveformMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScopeService_ReadWaveformMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).ReadWaveformMeasurement(ctx, req.(*WaveformMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScopeService_ServiceDesc is the grpc.ServiceDesc for ScopeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScopeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.ScopeService",
+	HandlerType: (*ScopeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChannels",
+			Handler:    _ScopeService_ListChannels_Handler,
+		},
+		{
+			MethodName: "SetChannelEnabled",
+			Handler:    _ScopeService_SetChannelEnabled_Handler,
+		},
+		{
+			MethodName: "FetchWaveformMeasurement",
+			Handler:    _ScopeService_FetchWaveformMeasurement_Handler,
+		},
+		{
+			MethodName: "ReadWaveformMeasurement",
+			Handler:    _ScopeService_ReadWaveformMeasurement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/scope.proto",
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: bench/v1/swtch.proto
+
+package benchv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChannelCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelCount) Reset() {
+	*x = ChannelCount{}
+	mi := &file_bench_v1_swtch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelCount) ProtoMessage() {}
+
+func (x *ChannelCount) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_swtch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelCount.ProtoReflect.Descriptor instead.
+func (*ChannelCount) Descriptor() ([]byte, []int) {
+	return file_bench_v1_swtch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChannelCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ConnectRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Instrument string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	// Channel names or virtual names, e.g., "Row1" and "Col2".
+	Channel1      string `protobuf:"bytes,2,opt,name=channel1,proto3" json:"channel1,omitempty"`
+	Channel2      string `protobuf:"bytes,3,opt,name=channel2,proto3" json:"channel2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	mi := &file_bench_v1_swtch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bench_v1_swtch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_bench_v1_swtch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnectRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetChannel1() string {
+	if x != nil {
+		return x.Channel1
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetChannel2() string {
+	if x != nil {
+		return x.Channel2
+	}
+	return ""
+}
+
+var File_bench_v1_swtch_proto protoreflect.FileDescriptor
+
+const file_bench_v1_swtch_proto_rawDesc = "" +
+	"\n" +
+	"\x14bench/v1/swtch.proto\x12\bbench.v1\x1a\x15bench/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"$\n" +
+	"\fChannelCount\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"h\n" +
+	"\x0eConnectRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x12\x1a\n" +
+	"\bchannel1\x18\x02 \x01(\tR\bchannel1\x12\x1a\n" +
+	"\bchannel2\x18\x03 \x01(\tR\bchannel22\x91\x02\n" +
+	"\fSwtchService\x12B\n" +
+	"\x0fGetChannelCount\x12\x17.bench.v1.InstrumentRef\x1a\x16.bench.v1.ChannelCount\x12;\n" +
+	"\aConnect\x12\x18.bench.v1.ConnectRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\n" +
+	"Disconnect\x12\x18.bench.v1.ConnectRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\rDisconnectAll\x12\x17.bench.v1.InstrumentRef\x1a\x16.google.protobuf.EmptyB<Z:github.com/gotmc/ivi-examples/internal/rpc/benchv1;benchv1b\x06proto3"
+
+var (
+	file_bench_v1_swtch_proto_rawDescOnce sync.Once
+	file_bench_v1_swtch_proto_rawDescData []byte
+)
+
+func file_bench_v1_swtch_proto_rawDescGZIP() []byte {
+	file_bench_v1_swtch_proto_rawDescOnce.Do(func() {
+		file_bench_v1_swtch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bench_v1_swtch_proto_rawDesc), len(file_bench_v1_swtch_proto_rawDesc)))
+	})
+	return file_bench_v1_swtch_proto_rawDescData
+}
+
+var file_bench_v1_swtch_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_bench_v1_swtch_proto_goTypes = []any{
+	(*ChannelCount)(nil),   // 0: bench.v1.ChannelCount
+	(*ConnectRequest)(nil), // 1: bench.v1.ConnectRequest
+	(*InstrumentRef)(nil),  // 2: bench.v1.InstrumentRef
+	(*emptypb.Empty)(nil),  // 3: google.protobuf.Empty
+}
+var file_bench_v1_swtch_proto_depIdxs = []int32{
+	2, // 0: bench.v1.SwtchService.GetChannelCount:input_type -> bench.v1.InstrumentRef
+	1, // 1: bench.v1.SwtchService.Connect:input_type -> bench.v1.ConnectRequest
+	1, // 2: bench.v1.SwtchService.Disconnect:input_type -> bench.v1.ConnectRequest
+	2, // 3: bench.v1.SwtchService.DisconnectAll:input_type -> bench.v1.InstrumentRef
+	0, // 4: bench.v1.SwtchService.GetChannelCount:output_type -> bench.v1.ChannelCount
+	3, // 5: bench.v1.SwtchService.Connect:output_type -> google.protobuf.Empty
+	3, // 6: bench.v1.SwtchService.Disconnect:output_type -> google.protobuf.Empty
+	3, // 7: bench.v1.SwtchService.DisconnectAll:output_type -> google.protobuf.Empty
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_bench_v1_swtch_proto_init() }
+func file_bench_v1_swtch_proto_init() {
+	if File_bench_v1_swtch_proto != nil {
+		return
+	}
+	file_bench_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bench_v1_swtch_proto_rawDesc), len(file_bench_v1_swtch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_bench_v1_swtch_proto_goTypes,
+		DependencyIndexes: file_bench_v1_swtch_proto_depIdxs,
+		MessageInfos:      file_bench_v1_swtch_proto_msgTypes,
+	}.Build()
+	File_bench_v1_swtch_proto = out.File
+	file_bench_v1_swtch_proto_goTypes = nil
+	file_bench_v1_swtch_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: bench/v1/swtch.proto
+
+package benchv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SwtchService_GetChannelCount_FullMethodName = "/bench.v1.SwtchService/GetChannelCount"
+	SwtchService_Connect_FullMethodName         = "/bench.v1.SwtchService/Connect"
+	SwtchService_Disconnect_FullMethodName      = "/bench.v1.SwtchService/Disconnect"
+	SwtchService_DisconnectAll_FullMethodName   = "/bench.v1.SwtchService/DisconnectAll"
+)
+
+// SwtchServiceClient is the client API for SwtchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SwtchService controls switch matrices.
+type SwtchServiceClient interface {
+	GetChannelCount(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelCount, error)
+	// Connect closes the path between two channels, e.g., a row and a column.
+	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Disconnect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DisconnectAll(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type swtchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSwtchServiceClient(cc grpc.ClientConnInterface) SwtchServiceClient {
+	return &swtchServiceClient{cc}
+}
+
+func (c *swtchServiceClient) GetChannelCount(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*ChannelCount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelCount)
+	err := c.cc.Invoke(ctx, SwtchService_GetChannelCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swtchServiceClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SwtchService_Connect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swtchServiceClient) Disconnect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SwtchService_Disconnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swtchServiceClient) DisconnectAll(ctx context.Context, in *InstrumentRef, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SwtchService_DisconnectAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SwtchServiceServer is the server API for SwtchService service.
+// All implementations must embed UnimplementedSwtchServiceServer
+// for forward compatibility.
+//
+// SwtchService controls switch matrices.
+type SwtchServiceServer interface {
+	GetChannelCount(context.Context, *InstrumentRef) (*ChannelCount, error)
+	// Connect closes the path between two channels, e.g., a row and a column.
+	Connect(context.Context, *ConnectRequest) (*emptypb.Empty, error)
+	Disconnect(context.Context, *ConnectRequest) (*emptypb.Empty, error)
+	DisconnectAll(context.Context, *InstrumentRef) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSwtchServiceServer()
+}
+
+// UnimplementedSwtchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSwtchServiceServer struct{}
+
+func (UnimplementedSwtchServiceServer) GetChannelCount(context.Context, *InstrumentRef) (*ChannelCount, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChannelCount not implemented")
+}
+func (UnimplementedSwtchServiceServer) Connect(context.Context, *ConnectRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedSwtchServiceServer) Disconnect(context.Context, *ConnectRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedSwtchServiceServer) DisconnectAll(context.Context, *InstrumentRef) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisconnectAll not implemented")
+}
+func (UnimplementedSwtchServiceServer) mustEmbedUnimplementedSwtchServiceServer() {}
+func (UnimplementedSwtchServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeSwtchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SwtchServiceServer will
+// result in compilation errors.
+type UnsafeSwtchServiceServer interface {
+	mustEmbedUnimplementedSwtchServiceServer()
+}
+
+func RegisterSwtchServiceServer(s grpc.ServiceRegistrar, srv SwtchServiceServer) {
+	// If the following call panics, it indicates UnimplementedSwtchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SwtchService_ServiceDesc, srv)
+}
+
+func _SwtchService_GetChannelCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwtchServiceServer).GetChannelCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwtchService_GetChannelCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwtchServiceServer).GetChannelCount(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwtchService_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwtchServiceServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwtchService_Connect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwtchServiceServer).Connect(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwtchService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwtchServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwtchService_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwtchServiceServer).Disconnect(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwtchService_DisconnectAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstrumentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwtchServiceServer).DisconnectAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SwtchService_DisconnectAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwtchServiceServer).DisconnectAll(ctx, req.(*InstrumentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SwtchService_ServiceDesc is the grpc.ServiceDesc for SwtchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SwtchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bench.v1.SwtchService",
+	HandlerType: (*SwtchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChannelCount",
+			Handler:    _SwtchService_GetChannelCount_Handler,
+		},
+		{
+			MethodName: "Connect",
+			Handler:    _SwtchService_Connect_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _SwtchService_Disconnect_Handler,
+		},
+		{
+			MethodName: "DisconnectAll",
+			Handler:    _SwtchService_DisconnectAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bench/v1/swtch.proto",
+}
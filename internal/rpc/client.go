@@ -0,0 +1,509 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/rpc/benchv1"
+	"github.com/gotmc/ivi/dcpwr"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Client accesses the instruments served by a bench gRPC server. The handles
+// returned by DCPwr, DMM, FGen, Scope, and Swtch satisfy the corresponding
+// bench interfaces. Since those interfaces have no context arguments, each
+// call is bounded by the client's timeout.
+type Client struct {
+	timeout time.Duration
+	inst    benchv1.InstrumentServiceClient
+	dcpwr   benchv1.DCPwrServiceClient
+	dmm     benchv1.DMMServiceClient
+	fgen    benchv1.FGenServiceClient
+	scope   benchv1.ScopeServiceClient
+	swtch   benchv1.SwtchServiceClient
+}
+
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithTimeout bounds each call made through the instrument handles. The
+// default is 10 s.
+func WithTimeout(d time.Duration) ClientOption { return func(c *Client) { c.timeout = d } }
+
+// NewClient creates a client using the connection, which is typically created
+// with grpc.NewClient. Closing the connection is left to the caller.
+func NewClient(conn grpc.ClientConnInterface, opts ...ClientOption) *Client {
+	c := Client{
+		timeout: 10 * time.Second,
+		inst:    benchv1.NewInstrumentServiceClient(conn),
+		dcpwr:   benchv1.NewDCPwrServiceClient(conn),
+		dmm:     benchv1.NewDMMServiceClient(conn),
+		fgen:    benchv1.NewFGenServiceClient(conn),
+		scope:   benchv1.NewScopeServiceClient(conn),
+		swtch:   benchv1.NewSwtchServiceClient(conn),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// call makes an RPC bounded by the client's timeout and converts a status
+// error back into the errors the local drivers return.
+func call[Req, Resp any](
+	c *Client,
+	rpc func(context.Context, Req, ...grpc.CallOption) (Resp, error),
+	req Req,
+) (Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := rpc(ctx, req)
+	return resp, fromStatus(err)
+}
+
+// fromStatus maps Unimplemented, DeadlineExceeded, and OutOfRange to ivi,
+// context, and limits errors so errors.Is works the same as with a local
+// driver.
+func fromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.Unimplemented:
+		return fmt.Errorf("%s: %w", st.Message(), ivi.ErrFunctionNotSupported)
+	case codes.DeadlineExceeded:
+		return fmt.Errorf("%s: %w", st.Message(), context.DeadlineExceeded)
+	case codes.OutOfRange:
+		return fmt.Errorf("%s: %w", st.Message(), limits.ErrLimitExceeded)
+	}
+	return err
+}
+
+// ListInstruments returns the instruments served.
+func (c *Client) ListInstruments(ctx context.Context) ([]*benchv1.Instrument, error) {
+	list, err := c.inst.ListInstruments(ctx, &emptypb.Empty{})
+	return list.GetInstruments(), fromStatus(err)
+}
+
+// DCPwr returns a handle to the named power supply.
+func (c *Client) DCPwr(name string) bench.DCPwr { return remoteDCPwr{remote{c, name}} }
+
+// DMM returns a handle to the named DMM.
+func (c *Client) DMM(name string) bench.DMM { return remoteDMM{remote{c, name}} }
+
+// FGen returns a handle to the named function generator.
+func (c *Client) FGen(name string) bench.FGen { return remoteFGen{remote{c, name}} }
+
+// Scope returns a handle to the named oscilloscope.
+func (c *Client) Scope(name string) bench.Scope { return remoteScope{remote{c, name}} }
+
+// Swtch returns a handle to the named switch matrix.
+func (c *Client) Swtch(name string) bench.Swtch { return remoteSwtch{remote{c, name}} }
+
+// remote implements the inherent capabilities of a served instrument.
+type remote struct {
+	c    *Client
+	name string
+}
+
+func (r remote) ref() *benchv1.InstrumentRef {
+	return &benchv1.InstrumentRef{Instrument: r.name}
+}
+
+func (r remote) identity() (*benchv1.Identity, error) {
+	return call(r.c, r.c.inst.Identify, r.ref())
+}
+
+func (r remote) InstrumentManufacturer() (string, error) {
+	id, err := r.identity()
+	return id.GetManufacturer(), err
+}
+
+func (r remote) InstrumentModel() (string, error) {
+	id, err := r.identity()
+	return id.GetModel(), err
+}
+
+func (r remote) InstrumentSerialNumber() (string, error) {
+	id, err := r.identity()
+	return id.GetSerialNumber(), err
+}
+
+func (r remote) FirmwareRevision() (string, error) {
+	id, err := r.identity()
+	return id.GetFirmwareRevision(), err
+}
+
+func (r remote) Clear() error {
+	_, err := call(r.c, r.c.inst.Clear, r.ref())
+	return err
+}
+
+func (r remote) Reset() error {
+	_, err := call(r.c, r.c.inst.Reset, r.ref())
+	return err
+}
+
+// Close does nothing since the instrument stays open on the server and the
+// connection belongs to the caller.
+func (r remote) Close() error { return nil }
+
+// channelName looks up the name of channel i, checking that it exists.
+func (r remote) channelName(
+	list func(context.Context, *benchv1.InstrumentRef, ...grpc.CallOption) (*benchv1.ChannelList, error),
+	i int,
+) (string, error) {
+	names, err := call(r.c, list, r.ref())
+	if err != nil {
+		return "", err
+	}
+	if i < 0 || i >= len(names.GetChannels()) {
+		return "", fmt.Errorf("%s: channel %d out of range (0-%d)", r.name, i, len(names.GetChannels())-1)
+	}
+	return names.GetChannels()[i], nil
+}
+
+// channelCount returns the number of channels, or 0 if they can't be listed
+// since the class interfaces don't return an error.
+func (r remote) channelCount(
+	list func(context.Context, *benchv1.InstrumentRef, ...grpc.CallOption) (*benchv1.ChannelList, error),
+) int {
+	names, err := call(r.c, list, r.ref())
+	if err != nil {
+		return 0
+	}
+	return len(names.GetChannels())
+}
+
+// remoteChannel identifies a channel of a served instrument.
+type remoteChannel struct {
+	c    *Client
+	ref  *benchv1.ChannelRef
+	name string
+}
+
+func (r remote) channel(i int, name string) remoteChannel {
+	return remoteChannel{
+		c:    r.c,
+		ref:  &benchv1.ChannelRef{Instrument: r.name, Channel: int32(i)},
+		name: name,
+	}
+}
+
+func (ch remoteChannel) Name() string { return ch.name }
+
+func (ch remoteChannel) setDouble(
+	rpc func(context.Context, *benchv1.SetDoubleRequest, ...grpc.CallOption) (*emptypb.Empty, error),
+	v float64,
+) error {
+	_, err := call(ch.c, rpc, &benchv1.SetDoubleRequest{Channel: ch.ref, Value: v})
+	return err
+}
+
+func (ch remoteChannel) getDouble(
+	rpc func(context.Context, *benchv1.ChannelRef, ...grpc.CallOption) (*benchv1.DoubleValue, error),
+) (float64, error) {
+	v, err := call(ch.c, rpc, ch.ref)
+	return v.GetValue(), err
+}
+
+func fromRemoteEnum[T iviEnum](e *benchv1.Enum, err error) (T, error) {
+	if err != nil {
+		return 0, err
+	}
+	return T(e.GetValue()), nil
+}
+
+// DC power supplies
+
+type remoteDCPwr struct{ remote }
+
+func (r remoteDCPwr) OutputChannelCount() int { return r.channelCount(r.c.dcpwr.ListChannels) }
+
+func (r remoteDCPwr) Channel(i int) (bench.DCPwrChannel, error) {
+	name, err := r.channelName(r.c.dcpwr.ListChannels, i)
+	if err != nil {
+		return nil, err
+	}
+	return remoteDCPwrChannel{r.channel(i, name)}, nil
+}
+
+type remoteDCPwrChannel struct{ remoteChannel }
+
+func (ch remoteDCPwrChannel) VoltageLevel() (float64, error) {
+	return ch.getDouble(ch.c.dcpwr.GetVoltageLevel)
+}
+
+func (ch remoteDCPwrChannel) SetVoltageLevel(v float64) error {
+	return ch.setDouble(ch.c.dcpwr.SetVoltageLevel, v)
+}
+
+func (ch remoteDCPwrChannel) CurrentLimit() (float64, error) {
+	return ch.getDouble(ch.c.dcpwr.GetCurrentLimit)
+}
+
+func (ch remoteDCPwrChannel) SetCurrentLimit(a float64) error {
+	return ch.setDouble(ch.c.dcpwr.SetCurrentLimit, a)
+}
+
+func (ch remoteDCPwrChannel) OutputEnabled() (bool, error) {
+	v, err := call(ch.c, ch.c.dcpwr.GetOutputEnabled, ch.ref)
+	return v.GetValue(), err
+}
+
+func (ch remoteDCPwrChannel) SetOutputEnabled(b bool) error {
+	_, err := call(ch.c, ch.c.dcpwr.SetOutputEnabled, &benchv1.SetBoolRequest{Channel: ch.ref, Value: b})
+	return err
+}
+
+func (ch remoteDCPwrChannel) OVPEnabled() (bool, error) {
+	ovp, err := call(ch.c, ch.c.dcpwr.GetOVP, ch.ref)
+	return ovp.GetEnabled(), err
+}
+
+func (ch remoteDCPwrChannel) OVPLimit() (float64, error) {
+	ovp, err := call(ch.c, ch.c.dcpwr.GetOVP, ch.ref)
+	return ovp.GetLimit(), err
+}
+
+func (ch remoteDCPwrChannel) ConfigureOVP(enabled bool, limit float64) error {
+	_, err := call(ch.c, ch.c.dcpwr.ConfigureOVP, &benchv1.ConfigureOVPRequest{
+		Channel: ch.ref,
+		Enabled: enabled,
+		Limit:   limit,
+	})
+	return err
+}
+
+func (ch remoteDCPwrChannel) MeasureVoltage() (float64, error) {
+	return ch.getDouble(ch.c.dcpwr.MeasureVoltage)
+}
+
+func (ch remoteDCPwrChannel) MeasureCurrent() (float64, error) {
+	return ch.getDouble(ch.c.dcpwr.MeasureCurrent)
+}
+
+func (ch remoteDCPwrChannel) QueryOutputState(state dcpwr.OutputState) (bool, error) {
+	v, err := call(ch.c, ch.c.dcpwr.QueryOutputState, &benchv1.QueryOutputStateRequest{
+		Channel: ch.ref,
+		State:   &benchv1.Enum{Value: int32(state)},
+	})
+	return v.GetValue(), err
+}
+
+// Digital multimeters
+
+type remoteDMM struct{ remote }
+
+func (r remoteDMM) MeasurementFunction() (dmm.MeasurementFunction, error) {
+	return fromRemoteEnum[dmm.MeasurementFunction](call(r.c, r.c.dmm.GetMeasurementFunction, r.ref()))
+}
+
+func (r remoteDMM) SetMeasurementFunction(fcn dmm.MeasurementFunction) error {
+	_, err := call(r.c, r.c.dmm.SetMeasurementFunction, &benchv1.SetMeasurementFunctionRequest{
+		Instrument: r.name,
+		Function:   &benchv1.Enum{Value: int32(fcn)},
+	})
+	return err
+}
+
+func (r remoteDMM) Range() (dmm.AutoRange, float64, error) {
+	rng, err := call(r.c, r.c.dmm.GetRange, r.ref())
+	if err != nil {
+		return 0, 0, err
+	}
+	return dmm.AutoRange(rng.GetAutoRange().GetValue()), rng.GetRange(), nil
+}
+
+func (r remoteDMM) SetRange(autoRange dmm.AutoRange, rng float64) error {
+	_, err := call(r.c, r.c.dmm.SetRange, &benchv1.SetRangeRequest{
+		Instrument: r.name,
+		Settings: &benchv1.RangeSettings{
+			AutoRange: &benchv1.Enum{Value: int32(autoRange)},
+			Range:     rng,
+		},
+	})
+	return err
+}
+
+func (r remoteDMM) ReadMeasurement(maxTime time.Duration) (float64, error) {
+	v, err := call(r.c, r.c.dmm.ReadMeasurement, &benchv1.ReadMeasurementRequest{
+		Instrument: r.name,
+		MaxTime:    durationpb.New(maxTime),
+	})
+	return v.GetValue(), err
+}
+
+// Function generators
+
+type remoteFGen struct{ remote }
+
+func (r remoteFGen) ChannelCount() int { return r.channelCount(r.c.fgen.ListChannels) }
+
+func (r remoteFGen) Channel(i int) (bench.FGenChannel, error) {
+	name, err := r.channelName(r.c.fgen.ListChannels, i)
+	if err != nil {
+		return nil, err
+	}
+	return remoteFGenChannel{r.channel(i, name)}, nil
+}
+
+type remoteFGenChannel struct{ remoteChannel }
+
+func (ch remoteFGenChannel) OutputEnabled() (bool, error) {
+	v, err := call(ch.c, ch.c.fgen.GetOutputEnabled, ch.ref)
+	return v.GetValue(), err
+}
+
+func (ch remoteFGenChannel) setOutput(b bool) error {
+	_, err := call(ch.c, ch.c.fgen.SetOutputEnabled, &benchv1.SetBoolRequest{Channel: ch.ref, Value: b})
+	return err
+}
+
+func (ch remoteFGenChannel) EnableOutput() error  { return ch.setOutput(true) }
+func (ch remoteFGenChannel) DisableOutput() error { return ch.setOutput(false) }
+
+func (ch remoteFGenChannel) StandardWaveform() (fgen.StandardWaveform, error) {
+	return fromRemoteEnum[fgen.StandardWaveform](call(ch.c, ch.c.fgen.GetStandardWaveform, ch.ref))
+}
+
+func (ch remoteFGenChannel) SetStandardWaveform(wave fgen.StandardWaveform) error {
+	_, err := call(ch.c, ch.c.fgen.SetStandardWaveform, &benchv1.SetStandardWaveformRequest{
+		Channel:  ch.ref,
+		Waveform: &benchv1.Enum{Value: int32(wave)},
+	})
+	return err
+}
+
+func (ch remoteFGenChannel) Frequency() (float64, error) {
+	return ch.getDouble(ch.c.fgen.GetFrequency)
+}
+
+func (ch remoteFGenChannel) SetFrequency(freq float64) error {
+	return ch.setDouble(ch.c.fgen.SetFrequency, freq)
+}
+
+func (ch remoteFGenChannel) Amplitude() (float64, error) {
+	return ch.getDouble(ch.c.fgen.GetAmplitude)
+}
+
+func (ch remoteFGenChannel) SetAmplitude(amp float64) error {
+	return ch.setDouble(ch.c.fgen.SetAmplitude, amp)
+}
+
+func (ch remoteFGenChannel) DCOffset() (float64, error) {
+	return ch.getDouble(ch.c.fgen.GetDCOffset)
+}
+
+func (ch remoteFGenChannel) SetDCOffset(offset float64) error {
+	return ch.setDouble(ch.c.fgen.SetDCOffset, offset)
+}
+
+func (ch remoteFGenChannel) StartPhase() (float64, error) {
+	return ch.getDouble(ch.c.fgen.GetStartPhase)
+}
+
+func (ch remoteFGenChannel) ConfigureStandardWaveform(
+	wave fgen.StandardWaveform,
+	amp, offset, freq, phase float64,
+) error {
+	_, err := call(ch.c, ch.c.fgen.ConfigureStandardWaveform, &benchv1.ConfigureStandardWaveformRequest{
+		Channel:    ch.ref,
+		Waveform:   &benchv1.Enum{Value: int32(wave)},
+		Amplitude:  amp,
+		DcOffset:   offset,
+		Frequency:  freq,
+		StartPhase: phase,
+	})
+	return err
+}
+
+// Oscilloscopes
+
+type remoteScope struct{ remote }
+
+func (r remoteScope) ChannelCount() int { return r.channelCount(r.c.scope.ListChannels) }
+
+func (r remoteScope) Channel(i int) (bench.ScopeChannel, error) {
+	name, err := r.channelName(r.c.scope.ListChannels, i)
+	if err != nil {
+		return nil, err
+	}
+	return remoteScopeChannel{r.channel(i, name)}, nil
+}
+
+type remoteScopeChannel struct{ remoteChannel }
+
+func (ch remoteScopeChannel) SetChannelEnabled(b bool) error {
+	_, err := call(ch.c, ch.c.scope.SetChannelEnabled, &benchv1.SetBoolRequest{Channel: ch.ref, Value: b})
+	return err
+}
+
+func (ch remoteScopeChannel) FetchWaveformMeasurement(fcn scope.MeasFunction) (float64, error) {
+	v, err := call(ch.c, ch.c.scope.FetchWaveformMeasurement, &benchv1.WaveformMeasurementRequest{
+		Channel:  ch.ref,
+		Function: &benchv1.Enum{Value: int32(fcn)},
+	})
+	return v.GetValue(), err
+}
+
+func (ch remoteScopeChannel) ReadWaveformMeasurement(
+	fcn scope.MeasFunction,
+	maxTime time.Duration,
+) (float64, error) {
+	v, err := call(ch.c, ch.c.scope.ReadWaveformMeasurement, &benchv1.WaveformMeasurementRequest{
+		Channel:  ch.ref,
+		Function: &benchv1.Enum{Value: int32(fcn)},
+		MaxTime:  durationpb.New(maxTime),
+	})
+	return v.GetValue(), err
+}
+
+// Switch matrices
+
+type remoteSwtch struct{ remote }
+
+// ChannelCount returns the number of channels, or 0 if it can't be queried.
+func (r remoteSwtch) ChannelCount() int {
+	n, err := call(r.c, r.c.swtch.GetChannelCount, r.ref())
+	if err != nil {
+		return 0
+	}
+	return int(n.GetCount())
+}
+
+func (r remoteSwtch) connectRequest(ch1, ch2 string) *benchv1.ConnectRequest {
+	return &benchv1.ConnectRequest{Instrument: r.name, Channel1: ch1, Channel2: ch2}
+}
+
+func (r remoteSwtch) Connect(ch1, ch2 string) error {
+	_, err := call(r.c, r.c.swtch.Connect, r.connectRequest(ch1, ch2))
+	return err
+}
+
+func (r remoteSwtch) Disconnect(ch1, ch2 string) error {
+	_, err := call(r.c, r.c.swtch.Disconnect, r.connectRequest(ch1, ch2))
+	return err
+}
+
+func (r remoteSwtch) DisconnectAll() error {
+	_, err := call(r.c, r.c.swtch.DisconnectAll, r.ref())
+	return err
+}
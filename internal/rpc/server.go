@@ -0,0 +1,772 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package rpc serves the instruments on a bench over gRPC using the services
+// defined in proto/bench/v1 and provides a client whose instrument handles
+// satisfy the same class interfaces as the local drivers in package bench, so
+// code written against bench.DCPwr or bench.DMM works with either.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gotmc/ivi"
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/limits"
+	"github.com/gotmc/ivi-examples/internal/rpc/benchv1"
+	"github.com/gotmc/ivi/dcpwr"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/fgen"
+	"github.com/gotmc/ivi/scope"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Register registers all of the bench services with s.
+func Register(s grpc.ServiceRegistrar, b *bench.Bench) {
+	base := base{bench: b}
+	benchv1.RegisterInstrumentServiceServer(s, &instrumentServer{base: base})
+	benchv1.RegisterDCPwrServiceServer(s, &dcpwrServer{base: base})
+	benchv1.RegisterDMMServiceServer(s, &dmmServer{base: base})
+	benchv1.RegisterFGenServiceServer(s, &fgenServer{base: base})
+	benchv1.RegisterScopeServiceServer(s, &scopeServer{base: base})
+	benchv1.RegisterSwtchServiceServer(s, &swtchServer{base: base})
+}
+
+var empty = &emptypb.Empty{}
+
+// defaultMaxTime is used when a request doesn't set a maximum measurement
+// time.
+const defaultMaxTime = time.Second
+
+// base holds what the services share.
+type base struct {
+	bench *bench.Bench
+}
+
+// lookup returns the named instrument, checking that it belongs to the class.
+// An empty class matches any instrument.
+func (b base) lookup(name string, class bench.Class) (*bench.Instrument, error) {
+	inst, ok := b.bench.Instrument(name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no instrument named %q", name)
+	}
+	if class != "" && inst.Class != class {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"instrument %q is a %s, not a %s", name, inst.Class, class)
+	}
+	return inst, nil
+}
+
+// with runs fn with exclusive access to the named instrument's driver, type
+// asserted to the class interface T.
+func with[T bench.Inherent](
+	ctx context.Context,
+	b base,
+	name string,
+	class bench.Class,
+	fn func(drv T) error,
+) error {
+	inst, err := b.lookup(name, class)
+	if err != nil {
+		return err
+	}
+	return toStatus(inst.Do(ctx, func(drv bench.Inherent) error {
+		return fn(drv.(T))
+	}))
+}
+
+// channel gets a channel from a driver, reporting a bad index as NotFound.
+func channel[C any](get func(int) (C, error), i int32) (C, error) {
+	ch, err := get(int(i))
+	if err != nil {
+		return ch, status.Errorf(codes.NotFound, "channel %d: %s", i, err)
+	}
+	return ch, nil
+}
+
+// toStatus converts a driver error into a gRPC status error. Setpoints
+// rejected by the limits are reported as OutOfRange and errors talking to the
+// instrument as Unavailable.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, limits.ErrLimitExceeded):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, ivi.ErrFunctionNotSupported), errors.Is(err, ivi.ErrNotImplemented):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// iviEnum is an IVI enum type such as dmm.MeasurementFunction.
+type iviEnum interface {
+	~int
+	fmt.Stringer
+}
+
+func toEnum[T iviEnum](v T) *benchv1.Enum {
+	return &benchv1.Enum{Value: int32(v), Name: v.String()}
+}
+
+// fromEnum converts an Enum, preferring its name over its value.
+func fromEnum[T iviEnum](e *benchv1.Enum) (T, error) {
+	if e == nil {
+		return 0, status.Error(codes.InvalidArgument, "missing enum value")
+	}
+	if e.GetName() == "" {
+		return T(e.GetValue()), nil
+	}
+	v, err := bench.ParseEnum[T](e.GetName())
+	if err != nil {
+		return v, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return v, nil
+}
+
+func maxTime(d *durationpb.Duration) (time.Duration, error) {
+	if d == nil {
+		return defaultMaxTime, nil
+	}
+	if err := d.CheckValid(); err != nil || d.AsDuration() <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid max_time %s", d)
+	}
+	return d.AsDuration(), nil
+}
+
+type instrumentServer struct {
+	benchv1.UnimplementedInstrumentServiceServer
+	base
+}
+
+func (s *instrumentServer) ListInstruments(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*benchv1.InstrumentList, error) {
+	var list benchv1.InstrumentList
+	for _, inst := range s.bench.Instruments() {
+		list.Instruments = append(list.Instruments, &benchv1.Instrument{
+			Name:    inst.Name,
+			Driver:  inst.Driver,
+			Class:   string(inst.Class),
+			Address: inst.Address,
+		})
+	}
+	return &list, nil
+}
+
+func (s *instrumentServer) Identify(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.Identity, error) {
+	var id benchv1.Identity
+	err := with(ctx, s.base, req.GetInstrument(), "", func(drv bench.Inherent) error {
+		var err error
+		if id.Manufacturer, err = drv.InstrumentManufacturer(); err != nil {
+			return fmt.Errorf("error querying instrument manufacturer: %w", err)
+		}
+		if id.Model, err = drv.InstrumentModel(); err != nil {
+			return fmt.Errorf("error querying instrument model: %w", err)
+		}
+		if id.SerialNumber, err = drv.InstrumentSerialNumber(); err != nil {
+			return fmt.Errorf("error querying instrument sn: %w", err)
+		}
+		if id.FirmwareRevision, err = drv.FirmwareRevision(); err != nil {
+			return fmt.Errorf("error querying firmware revision: %w", err)
+		}
+		return nil
+	})
+	return &id, err
+}
+
+func (s *instrumentServer) Reset(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*emptypb.Empty, error) {
+	return empty, with(ctx, s.base, req.GetInstrument(), "", func(drv bench.Inherent) error {
+		return drv.Reset()
+	})
+}
+
+func (s *instrumentServer) Clear(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*emptypb.Empty, error) {
+	return empty, with(ctx, s.base, req.GetInstrument(), "", func(drv bench.Inherent) error {
+		return drv.Clear()
+	})
+}
+
+type dcpwrServer struct {
+	benchv1.UnimplementedDCPwrServiceServer
+	base
+}
+
+// withChannel runs fn with exclusive access to the power supply output.
+func (s *dcpwrServer) withChannel(
+	ctx context.Context,
+	ref *benchv1.ChannelRef,
+	fn func(ch bench.DCPwrChannel) error,
+) error {
+	return with(ctx, s.base, ref.GetInstrument(), bench.ClassDCPwr, func(ps bench.DCPwr) error {
+		ch, err := channel(ps.Channel, ref.GetChannel())
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (s *dcpwrServer) ListChannels(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.ChannelList, error) {
+	var list benchv1.ChannelList
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassDCPwr, func(ps bench.DCPwr) error {
+		for i := range ps.OutputChannelCount() {
+			ch, err := ps.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return &list, err
+}
+
+// getDouble and setDouble implement the RPCs reading and writing a single
+// float64 channel property.
+func (s *dcpwrServer) getDouble(
+	ctx context.Context,
+	ref *benchv1.ChannelRef,
+	get func(ch bench.DCPwrChannel) (float64, error),
+) (*benchv1.DoubleValue, error) {
+	var v benchv1.DoubleValue
+	err := s.withChannel(ctx, ref, func(ch bench.DCPwrChannel) error {
+		var err error
+		v.Value, err = get(ch)
+		return err
+	})
+	return &v, err
+}
+
+func (s *dcpwrServer) setDouble(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+	set func(ch bench.DCPwrChannel, v float64) error,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.DCPwrChannel) error {
+		return set(ch, req.GetValue())
+	})
+}
+
+func (s *dcpwrServer) GetVoltageLevel(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.DCPwrChannel.VoltageLevel)
+}
+
+func (s *dcpwrServer) SetVoltageLevel(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+) (*emptypb.Empty, error) {
+	return s.setDouble(ctx, req, bench.DCPwrChannel.SetVoltageLevel)
+}
+
+func (s *dcpwrServer) GetCurrentLimit(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.DCPwrChannel.CurrentLimit)
+}
+
+func (s *dcpwrServer) SetCurrentLimit(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+) (*emptypb.Empty, error) {
+	return s.setDouble(ctx, req, bench.DCPwrChannel.SetCurrentLimit)
+}
+
+func (s *dcpwrServer) GetOutputEnabled(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.BoolValue, error) {
+	var v benchv1.BoolValue
+	err := s.withChannel(ctx, req, func(ch bench.DCPwrChannel) error {
+		var err error
+		v.Value, err = ch.OutputEnabled()
+		return err
+	})
+	return &v, err
+}
+
+func (s *dcpwrServer) SetOutputEnabled(
+	ctx context.Context,
+	req *benchv1.SetBoolRequest,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.DCPwrChannel) error {
+		return ch.SetOutputEnabled(req.GetValue())
+	})
+}
+
+func (s *dcpwrServer) GetOVP(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.OVPSettings, error) {
+	var ovp benchv1.OVPSettings
+	err := s.withChannel(ctx, req, func(ch bench.DCPwrChannel) error {
+		var err error
+		if ovp.Enabled, err = ch.OVPEnabled(); err != nil {
+			return err
+		}
+		ovp.Limit, err = ch.OVPLimit()
+		return err
+	})
+	return &ovp, err
+}
+
+func (s *dcpwrServer) ConfigureOVP(
+	ctx context.Context,
+	req *benchv1.ConfigureOVPRequest,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.DCPwrChannel) error {
+		return ch.ConfigureOVP(req.GetEnabled(), req.GetLimit())
+	})
+}
+
+func (s *dcpwrServer) MeasureVoltage(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.DCPwrChannel.MeasureVoltage)
+}
+
+func (s *dcpwrServer) MeasureCurrent(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.DCPwrChannel.MeasureCurrent)
+}
+
+func (s *dcpwrServer) QueryOutputState(
+	ctx context.Context,
+	req *benchv1.QueryOutputStateRequest,
+) (*benchv1.BoolValue, error) {
+	state, err := fromEnum[dcpwr.OutputState](req.GetState())
+	if err != nil {
+		return nil, err
+	}
+	var v benchv1.BoolValue
+	err = s.withChannel(ctx, req.GetChannel(), func(ch bench.DCPwrChannel) error {
+		var err error
+		v.Value, err = ch.QueryOutputState(state)
+		return err
+	})
+	return &v, err
+}
+
+type dmmServer struct {
+	benchv1.UnimplementedDMMServiceServer
+	base
+}
+
+func (s *dmmServer) GetMeasurementFunction(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.Enum, error) {
+	var fcn dmm.MeasurementFunction
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassDMM, func(d bench.DMM) error {
+		var err error
+		fcn, err = d.MeasurementFunction()
+		return err
+	})
+	return toEnum(fcn), err
+}
+
+func (s *dmmServer) SetMeasurementFunction(
+	ctx context.Context,
+	req *benchv1.SetMeasurementFunctionRequest,
+) (*emptypb.Empty, error) {
+	fcn, err := fromEnum[dmm.MeasurementFunction](req.GetFunction())
+	if err != nil {
+		return nil, err
+	}
+	return empty, with(ctx, s.base, req.GetInstrument(), bench.ClassDMM, func(d bench.DMM) error {
+		return d.SetMeasurementFunction(fcn)
+	})
+}
+
+func (s *dmmServer) GetRange(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.RangeSettings, error) {
+	var (
+		auto dmm.AutoRange
+		rng  float64
+	)
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassDMM, func(d bench.DMM) error {
+		var err error
+		auto, rng, err = d.Range()
+		return err
+	})
+	return &benchv1.RangeSettings{AutoRange: toEnum(auto), Range: rng}, err
+}
+
+func (s *dmmServer) SetRange(
+	ctx context.Context,
+	req *benchv1.SetRangeRequest,
+) (*emptypb.Empty, error) {
+	auto, err := fromEnum[dmm.AutoRange](req.GetSettings().GetAutoRange())
+	if err != nil {
+		return nil, err
+	}
+	return empty, with(ctx, s.base, req.GetInstrument(), bench.ClassDMM, func(d bench.DMM) error {
+		return d.SetRange(auto, req.GetSettings().GetRange())
+	})
+}
+
+func (s *dmmServer) ReadMeasurement(
+	ctx context.Context,
+	req *benchv1.ReadMeasurementRequest,
+) (*benchv1.DoubleValue, error) {
+	maxTime, err := maxTime(req.GetMaxTime())
+	if err != nil {
+		return nil, err
+	}
+	var v benchv1.DoubleValue
+	err = with(ctx, s.base, req.GetInstrument(), bench.ClassDMM, func(d bench.DMM) error {
+		var err error
+		v.Value, err = d.ReadMeasurement(maxTime)
+		return err
+	})
+	return &v, err
+}
+
+type fgenServer struct {
+	benchv1.UnimplementedFGenServiceServer
+	base
+}
+
+// withChannel runs fn with exclusive access to the function generator output.
+func (s *fgenServer) withChannel(
+	ctx context.Context,
+	ref *benchv1.ChannelRef,
+	fn func(ch bench.FGenChannel) error,
+) error {
+	return with(ctx, s.base, ref.GetInstrument(), bench.ClassFGen, func(fg bench.FGen) error {
+		ch, err := channel(fg.Channel, ref.GetChannel())
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (s *fgenServer) getDouble(
+	ctx context.Context,
+	ref *benchv1.ChannelRef,
+	get func(ch bench.FGenChannel) (float64, error),
+) (*benchv1.DoubleValue, error) {
+	var v benchv1.DoubleValue
+	err := s.withChannel(ctx, ref, func(ch bench.FGenChannel) error {
+		var err error
+		v.Value, err = get(ch)
+		return err
+	})
+	return &v, err
+}
+
+func (s *fgenServer) setDouble(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+	set func(ch bench.FGenChannel, v float64) error,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.FGenChannel) error {
+		return set(ch, req.GetValue())
+	})
+}
+
+func (s *fgenServer) ListChannels(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.ChannelList, error) {
+	var list benchv1.ChannelList
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassFGen, func(fg bench.FGen) error {
+		for i := range fg.ChannelCount() {
+			ch, err := fg.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return &list, err
+}
+
+func (s *fgenServer) ConfigureStandardWaveform(
+	ctx context.Context,
+	req *benchv1.ConfigureStandardWaveformRequest,
+) (*emptypb.Empty, error) {
+	wave, err := fromEnum[fgen.StandardWaveform](req.GetWaveform())
+	if err != nil {
+		return nil, err
+	}
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.FGenChannel) error {
+		return ch.ConfigureStandardWaveform(
+			wave,
+			req.GetAmplitude(),
+			req.GetDcOffset(),
+			req.GetFrequency(),
+			req.GetStartPhase(),
+		)
+	})
+}
+
+func (s *fgenServer) GetStandardWaveform(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.Enum, error) {
+	var wave fgen.StandardWaveform
+	err := s.withChannel(ctx, req, func(ch bench.FGenChannel) error {
+		var err error
+		wave, err = ch.StandardWaveform()
+		return err
+	})
+	return toEnum(wave), err
+}
+
+func (s *fgenServer) SetStandardWaveform(
+	ctx context.Context,
+	req *benchv1.SetStandardWaveformRequest,
+) (*emptypb.Empty, error) {
+	wave, err := fromEnum[fgen.StandardWaveform](req.GetWaveform())
+	if err != nil {
+		return nil, err
+	}
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.FGenChannel) error {
+		return ch.SetStandardWaveform(wave)
+	})
+}
+
+func (s *fgenServer) GetFrequency(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.FGenChannel.Frequency)
+}
+
+func (s *fgenServer) SetFrequency(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+) (*emptypb.Empty, error) {
+	return s.setDouble(ctx, req, bench.FGenChannel.SetFrequency)
+}
+
+func (s *fgenServer) GetAmplitude(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.FGenChannel.Amplitude)
+}
+
+func (s *fgenServer) SetAmplitude(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+) (*emptypb.Empty, error) {
+	return s.setDouble(ctx, req, bench.FGenChannel.SetAmplitude)
+}
+
+func (s *fgenServer) GetDCOffset(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.FGenChannel.DCOffset)
+}
+
+func (s *fgenServer) SetDCOffset(
+	ctx context.Context,
+	req *benchv1.SetDoubleRequest,
+) (*emptypb.Empty, error) {
+	return s.setDouble(ctx, req, bench.FGenChannel.SetDCOffset)
+}
+
+func (s *fgenServer) GetStartPhase(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.DoubleValue, error) {
+	return s.getDouble(ctx, req, bench.FGenChannel.StartPhase)
+}
+
+func (s *fgenServer) GetOutputEnabled(
+	ctx context.Context,
+	req *benchv1.ChannelRef,
+) (*benchv1.BoolValue, error) {
+	var v benchv1.BoolValue
+	err := s.withChannel(ctx, req, func(ch bench.FGenChannel) error {
+		var err error
+		v.Value, err = ch.OutputEnabled()
+		return err
+	})
+	return &v, err
+}
+
+func (s *fgenServer) SetOutputEnabled(
+	ctx context.Context,
+	req *benchv1.SetBoolRequest,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.FGenChannel) error {
+		if req.GetValue() {
+			return ch.EnableOutput()
+		}
+		return ch.DisableOutput()
+	})
+}
+
+type scopeServer struct {
+	benchv1.UnimplementedScopeServiceServer
+	base
+}
+
+// withChannel runs fn with exclusive access to the oscilloscope input.
+func (s *scopeServer) withChannel(
+	ctx context.Context,
+	ref *benchv1.ChannelRef,
+	fn func(ch bench.ScopeChannel) error,
+) error {
+	return with(ctx, s.base, ref.GetInstrument(), bench.ClassScope, func(sc bench.Scope) error {
+		ch, err := channel(sc.Channel, ref.GetChannel())
+		if err != nil {
+			return err
+		}
+		return fn(ch)
+	})
+}
+
+func (s *scopeServer) ListChannels(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.ChannelList, error) {
+	var list benchv1.ChannelList
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassScope, func(sc bench.Scope) error {
+		for i := range sc.ChannelCount() {
+			ch, err := sc.Channel(i)
+			if err != nil {
+				return err
+			}
+			list.Channels = append(list.Channels, ch.Name())
+		}
+		return nil
+	})
+	return &list, err
+}
+
+func (s *scopeServer) SetChannelEnabled(
+	ctx context.Context,
+	req *benchv1.SetBoolRequest,
+) (*emptypb.Empty, error) {
+	return empty, s.withChannel(ctx, req.GetChannel(), func(ch bench.ScopeChannel) error {
+		return ch.SetChannelEnabled(req.GetValue())
+	})
+}
+
+func (s *scopeServer) FetchWaveformMeasurement(
+	ctx context.Context,
+	req *benchv1.WaveformMeasurementRequest,
+) (*benchv1.DoubleValue, error) {
+	fcn, err := fromEnum[scope.MeasFunction](req.GetFunction())
+	if err != nil {
+		return nil, err
+	}
+	var v benchv1.DoubleValue
+	err = s.withChannel(ctx, req.GetChannel(), func(ch bench.ScopeChannel) error {
+		var err error
+		v.Value, err = ch.FetchWaveformMeasurement(fcn)
+		return err
+	})
+	return &v, err
+}
+
+func (s *scopeServer) ReadWaveformMeasurement(
+	ctx context.Context,
+	req *benchv1.WaveformMeasurementRequest,
+) (*benchv1.DoubleValue, error) {
+	fcn, err := fromEnum[scope.MeasFunction](req.GetFunction())
+	if err != nil {
+		return nil, err
+	}
+	maxTime, err := maxTime(req.GetMaxTime())
+	if err != nil {
+		return nil, err
+	}
+	var v benchv1.DoubleValue
+	err = s.withChannel(ctx, req.GetChannel(), func(ch bench.ScopeChannel) error {
+		var err error
+		v.Value, err = ch.ReadWaveformMeasurement(fcn, maxTime)
+		return err
+	})
+	return &v, err
+}
+
+type swtchServer struct {
+	benchv1.UnimplementedSwtchServiceServer
+	base
+}
+
+func (s *swtchServer) GetChannelCount(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*benchv1.ChannelCount, error) {
+	var n benchv1.ChannelCount
+	err := with(ctx, s.base, req.GetInstrument(), bench.ClassSwtch, func(sw bench.Swtch) error {
+		n.Count = int32(sw.ChannelCount())
+		return nil
+	})
+	return &n, err
+}
+
+func (s *swtchServer) Connect(
+	ctx context.Context,
+	req *benchv1.ConnectRequest,
+) (*emptypb.Empty, error) {
+	return empty, with(ctx, s.base, req.GetInstrument(), bench.ClassSwtch, func(sw bench.Swtch) error {
+		return sw.Connect(req.GetChannel1(), req.GetChannel2())
+	})
+}
+
+func (s *swtchServer) Disconnect(
+	ctx context.Context,
+	req *benchv1.ConnectRequest,
+) (*emptypb.Empty, error) {
+	return empty, with(ctx, s.base, req.GetInstrument(), bench.ClassSwtch, func(sw bench.Swtch) error {
+		return sw.Disconnect(req.GetChannel1(), req.GetChannel2())
+	})
+}
+
+func (s *swtchServer) DisconnectAll(
+	ctx context.Context,
+	req *benchv1.InstrumentRef,
+) (*emptypb.Empty, error) {
+	return empty, with(ctx, s.base, req.GetInstrument(), bench.ClassSwtch, func(sw bench.Swtch) error {
+		return sw.DisconnectAll()
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package scpi reads the IEEE 488.2 arbitrary binary blocks returned by
+// queries such as :WAVeform:DATA? and HCOPy:SDUMp:DATA?.
+//
+// A definite length block is "#", one digit n, n digits giving the length,
+// and then the data, e.g., "#15hello". An indefinite length block is "#0"
+// followed by data up to a final newline.
+package scpi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ErrNotBlock is returned when a response doesn't start with "#".
+var ErrNotBlock = errors.New("scpi: response isn't a binary block")
+
+// Transport is the part of an ivi.Transport used to read a block.
+type Transport interface {
+	Command(ctx context.Context, cmd string, a ...any) error
+	ReadBinary(ctx context.Context, p []byte) (int, error)
+}
+
+// IsBlock reports whether the response starts like a binary block.
+func IsBlock(b []byte) bool {
+	return len(b) >= 2 && b[0] == '#' && b[1] >= '0' && b[1] <= '9'
+}
+
+// ParseBlock parses the block at the start of b, returning its data and the
+// number of bytes of b it used. If b holds only part of the block, the error
+// is io.ErrUnexpectedEOF.
+func ParseBlock(b []byte) (data []byte, n int, err error) {
+	if !IsBlock(b) {
+		return nil, 0, ErrNotBlock
+	}
+	digits := int(b[1] - '0')
+	if digits == 0 {
+		if len(b) < 3 || b[len(b)-1] != '\n' {
+			return nil, 0, io.ErrUnexpectedEOF
+		}
+		return b[2 : len(b)-1], len(b), nil
+	}
+	if len(b) < 2+digits {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := strconv.Atoi(string(b[2 : 2+digits]))
+	if err != nil || length < 0 {
+		return nil, 0, fmt.Errorf("scpi: invalid block length %q", b[2:2+digits])
+	}
+	end := 2 + digits + length
+	if len(b) < end {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	return b[2+digits : end], end, nil
+}
+
+// ReadBlock sends the query and reads the binary block it returns. The
+// response is read until the whole block has arrived, since a block may span
+// several reads. The newline following a definite length block is read and
+// discarded, so that it isn't taken as the start of the next response.
+func ReadBlock(ctx context.Context, t Transport, query string) ([]byte, error) {
+	if err := t.Command(ctx, query); err != nil {
+		return nil, err
+	}
+	var (
+		buf   []byte
+		chunk = make([]byte, 64*1024)
+	)
+	for {
+		n, err := t.ReadBinary(ctx, chunk)
+		buf = append(buf, chunk[:n]...)
+		data, used, perr := ParseBlock(buf)
+		switch {
+		case perr == nil:
+			if buf[1] != '0' && bytes.IndexByte(buf[used:], '\n') < 0 {
+				if err := readTerminator(ctx, t); err != nil {
+					return nil, err
+				}
+			}
+			return data, nil
+		case !errors.Is(perr, io.ErrUnexpectedEOF):
+			return nil, perr
+		case err != nil:
+			return nil, fmt.Errorf("scpi: read %d bytes of block: %w", len(buf), err)
+		case n == 0:
+			// A transport without a deadline could return nothing forever.
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("scpi: read %d bytes of block: %w", len(buf), io.ErrUnexpectedEOF)
+		}
+	}
+}
+
+// readTerminator reads the newline that ends a response after a definite
+// length block, skipping a carriage return before it.
+func readTerminator(ctx context.Context, t Transport) error {
+	b := make([]byte, 1)
+	for {
+		n, err := t.ReadBinary(ctx, b)
+		switch {
+		case n == 1 && b[0] == '\r':
+		case n == 1 && b[0] == '\n':
+			return nil
+		case n == 1:
+			return fmt.Errorf("scpi: block followed by %q instead of a newline", b[0])
+		case err != nil:
+			return fmt.Errorf("scpi: read block terminator: %w", err)
+		default:
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return fmt.Errorf("scpi: read block terminator: %w", io.ErrUnexpectedEOF)
+		}
+	}
+}
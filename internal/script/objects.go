@@ -0,0 +1,531 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package script
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi/dmm"
+	"github.com/gotmc/ivi/fgen"
+	"go.starlark.net/starlark"
+)
+
+type builtin = func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error)
+
+// object is an instrument, or a channel of one, exposed to Starlark as a
+// value whose attributes are its methods.
+type object struct {
+	typ     string
+	name    string
+	methods map[string]*starlark.Builtin
+	docs    map[string]string
+}
+
+func newObject(typ, name string) *object {
+	return &object{
+		typ:     typ,
+		name:    name,
+		methods: make(map[string]*starlark.Builtin),
+		docs:    make(map[string]string),
+	}
+}
+
+// add adds a method. The signature is documented by help.
+func (o *object) add(signature, doc string, fn builtin) {
+	name, _, _ := strings.Cut(signature, "(")
+	o.methods[name] = starlark.NewBuiltin(o.typ+"."+name, fn)
+	o.docs[name] = fmt.Sprintf("%-40s %s", signature, doc)
+}
+
+func (o *object) String() string        { return fmt.Sprintf("%s(%q)", o.typ, o.name) }
+func (o *object) Type() string          { return o.typ }
+func (o *object) Freeze()               {}
+func (o *object) Truth() starlark.Bool  { return starlark.True }
+func (o *object) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", o.typ) }
+
+func (o *object) Attr(name string) (starlark.Value, error) {
+	if m, ok := o.methods[name]; ok {
+		return m, nil
+	}
+	return nil, nil
+}
+
+func (o *object) AttrNames() []string {
+	names := make([]string, 0, len(o.methods))
+	for name := range o.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (o *object) help() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s methods:", o)
+	for _, name := range o.AttrNames() {
+		fmt.Fprintf(&sb, "\n  %s", o.docs[name])
+	}
+	return sb.String()
+}
+
+// use runs fn with the instrument's driver as a T, serialized with any other
+// user of the instrument.
+func use[T any](thread *starlark.Thread, inst *bench.Instrument, fn func(T) error) error {
+	return useContext(threadContext(thread), inst, fn)
+}
+
+// useContext is like use but with a context other than the procedure's.
+func useContext[T any](ctx context.Context, inst *bench.Instrument, fn func(T) error) error {
+	return inst.Do(ctx, func(drv bench.Inherent) error {
+		d, ok := drv.(T)
+		if !ok {
+			return fmt.Errorf("%s: %s driver isn't a %s", inst.Name, inst.Driver, inst.Class)
+		}
+		return fn(d)
+	})
+}
+
+// setOutput returns the builtin turning an output on or off with set. The
+// outputs left on are turned off by Runner.Run if the procedure stops early.
+func setOutput(name string, on bool, set func(ctx context.Context, on bool) error) builtin {
+	return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+			return nil, err
+		}
+		err := set(threadContext(thread), on)
+		// An output that failed to turn on may still be on.
+		if on || err == nil {
+			outputs := thread.Local(outputsKey).(*enabledOutputs)
+			outputs.set(name, on, func(ctx context.Context) error { return set(ctx, false) })
+		}
+		return noneValue(err)
+	}
+}
+
+func floatValue(v float64, err error) (starlark.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Float(v), nil
+}
+
+func boolValue(v bool, err error) (starlark.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Bool(v), nil
+}
+
+func noneValue(err error) (starlark.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, nil
+}
+
+// newInstrument returns an object with the methods shared by every class.
+func newInstrument(inst *bench.Instrument) *object {
+	o := newObject(string(inst.Class), inst.Name)
+	o.add("identity()", "dict of manufacturer, model, serial_number, and firmware",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			d := starlark.NewDict(4)
+			err := use(thread, inst, func(drv bench.Inherent) error {
+				for _, q := range []struct {
+					key string
+					fn  func() (string, error)
+				}{
+					{"manufacturer", drv.InstrumentManufacturer},
+					{"model", drv.InstrumentModel},
+					{"serial_number", drv.InstrumentSerialNumber},
+					{"firmware", drv.FirmwareRevision},
+				} {
+					v, err := q.fn()
+					if err != nil {
+						return fmt.Errorf("%s: error querying %s: %w", inst.Name, q.key, err)
+					}
+					if err := d.SetKey(starlark.String(q.key), starlark.String(v)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return d, nil
+		})
+	o.add("reset()", "reset the instrument",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			return noneValue(use(thread, inst, func(drv bench.Inherent) error { return drv.Reset() }))
+		})
+	return o
+}
+
+// channelIndex unpacks the argument of channel(), which is either the index
+// or the name of the channel.
+func channelIndex(b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple,
+	names func() []string,
+) (int, error) {
+	var v starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &v); err != nil {
+		return 0, err
+	}
+	if i, err := starlark.AsInt32(v); err == nil {
+		return i, nil
+	}
+	name, ok := starlark.AsString(v)
+	if !ok {
+		return 0, fmt.Errorf("got %s, want a channel index or name", v.Type())
+	}
+	for i, n := range names() {
+		if strings.EqualFold(n, name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no channel named %q", name)
+}
+
+func newDCPwr(inst *bench.Instrument) *object {
+	o := newInstrument(inst)
+	o.add("channel(index or name)", "output channel",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var name string
+			i, err := channelIndex(b, args, kwargs, func() []string {
+				var names []string
+				_ = use(thread, inst, func(ps bench.DCPwr) error {
+					for i := range ps.OutputChannelCount() {
+						if ch, err := ps.Channel(i); err == nil {
+							names = append(names, ch.Name())
+						}
+					}
+					return nil
+				})
+				return names
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = use(thread, inst, func(ps bench.DCPwr) error {
+				ch, err := ps.Channel(i)
+				if err != nil {
+					return err
+				}
+				name = ch.Name()
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return newDCPwrChannel(inst, i, name), nil
+		})
+	return o
+}
+
+func newDCPwrChannel(inst *bench.Instrument, i int, name string) *object {
+	o := newObject("dcpwr.channel", inst.Name+" "+name)
+	do := func(thread *starlark.Thread, fn func(bench.DCPwrChannel) error) error {
+		return use(thread, inst, func(ps bench.DCPwr) error {
+			ch, err := ps.Channel(i)
+			if err != nil {
+				return err
+			}
+			return fn(ch)
+		})
+	}
+	getFloat := func(get func(bench.DCPwrChannel) (float64, error)) builtin {
+		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			var v float64
+			err := do(thread, func(ch bench.DCPwrChannel) (err error) {
+				v, err = get(ch)
+				return err
+			})
+			return floatValue(v, err)
+		}
+	}
+	setFloat := func(set func(bench.DCPwrChannel, float64) error) builtin {
+		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var v number
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &v); err != nil {
+				return nil, err
+			}
+			return noneValue(do(thread, func(ch bench.DCPwrChannel) error { return set(ch, float64(v)) }))
+		}
+	}
+	setEnabled := func(ctx context.Context, on bool) error {
+		return useContext(ctx, inst, func(ps bench.DCPwr) error {
+			ch, err := ps.Channel(i)
+			if err != nil {
+				return err
+			}
+			return ch.SetOutputEnabled(on)
+		})
+	}
+
+	o.add("configure(voltage, current_limit)", "set the voltage (V) and current limit (A)",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var v, a number
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "voltage", &v, "current_limit", &a); err != nil {
+				return nil, err
+			}
+			return noneValue(do(thread, func(ch bench.DCPwrChannel) error {
+				if err := ch.SetVoltageLevel(float64(v)); err != nil {
+					return err
+				}
+				return ch.SetCurrentLimit(float64(a))
+			}))
+		})
+	o.add("configure_ovp(enabled, limit)", "enable or disable OVP at limit (V)",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				enabled bool
+				limit   number
+			)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "enabled", &enabled, "limit", &limit); err != nil {
+				return nil, err
+			}
+			return noneValue(do(thread, func(ch bench.DCPwrChannel) error { return ch.ConfigureOVP(enabled, float64(limit)) }))
+		})
+	o.add("set_voltage(v)", "set the voltage level (V)", setFloat(bench.DCPwrChannel.SetVoltageLevel))
+	o.add("voltage()", "voltage level (V)", getFloat(bench.DCPwrChannel.VoltageLevel))
+	o.add("set_current_limit(a)", "set the current limit (A)", setFloat(bench.DCPwrChannel.SetCurrentLimit))
+	o.add("current_limit()", "current limit (A)", getFloat(bench.DCPwrChannel.CurrentLimit))
+	o.add("measure_voltage()", "measured output voltage (V)", getFloat(bench.DCPwrChannel.MeasureVoltage))
+	o.add("measure_current()", "measured output current (A)", getFloat(bench.DCPwrChannel.MeasureCurrent))
+	o.add("enable_output()", "turn the output on", setOutput(o.name, true, setEnabled))
+	o.add("disable_output()", "turn the output off", setOutput(o.name, false, setEnabled))
+	o.add("output_enabled()", "whether the output is on",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			var on bool
+			err := do(thread, func(ch bench.DCPwrChannel) (err error) {
+				on, err = ch.OutputEnabled()
+				return err
+			})
+			return boolValue(on, err)
+		})
+	return o
+}
+
+func newDMM(inst *bench.Instrument) *object {
+	o := newInstrument(inst)
+	o.add("configure(function, range = None)", "set the function, e.g., \"DCVolts\", and range (None = auto)",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				name string
+				rng  optionalFloat
+			)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "function", &name, "range?", &rng); err != nil {
+				return nil, err
+			}
+			fcn, err := bench.ParseEnum[dmm.MeasurementFunction](name)
+			if err != nil {
+				return nil, err
+			}
+			return noneValue(use(thread, inst, func(m bench.DMM) error {
+				if err := m.SetMeasurementFunction(fcn); err != nil {
+					return err
+				}
+				if rng.v == nil {
+					return m.SetRange(dmm.AutoOn, 0)
+				}
+				return m.SetRange(dmm.AutoOff, *rng.v)
+			}))
+		})
+	o.add("function()", "measurement function",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			var fcn dmm.MeasurementFunction
+			err := use(thread, inst, func(m bench.DMM) (err error) {
+				fcn, err = m.MeasurementFunction()
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+			return starlark.String(fcn.String()), nil
+		})
+	o.add("read(max_time = \"5s\")", "take a measurement",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			maxTime := duration(5 * time.Second)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "max_time?", &maxTime); err != nil {
+				return nil, err
+			}
+			var v float64
+			err := use(thread, inst, func(m bench.DMM) (err error) {
+				v, err = m.ReadMeasurement(time.Duration(maxTime))
+				return err
+			})
+			return floatValue(v, err)
+		})
+	return o
+}
+
+func newFGen(inst *bench.Instrument) *object {
+	o := newInstrument(inst)
+	o.add("channel(index or name)", "output channel",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var name string
+			i, err := channelIndex(b, args, kwargs, func() []string {
+				var names []string
+				_ = use(thread, inst, func(fg bench.FGen) error {
+					for i := range fg.ChannelCount() {
+						if ch, err := fg.Channel(i); err == nil {
+							names = append(names, ch.Name())
+						}
+					}
+					return nil
+				})
+				return names
+			})
+			if err != nil {
+				return nil, err
+			}
+			err = use(thread, inst, func(fg bench.FGen) error {
+				ch, err := fg.Channel(i)
+				if err != nil {
+					return err
+				}
+				name = ch.Name()
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return newFGenChannel(inst, i, name), nil
+		})
+	return o
+}
+
+func newFGenChannel(inst *bench.Instrument, i int, name string) *object {
+	o := newObject("fgen.channel", inst.Name+" "+name)
+	do := func(thread *starlark.Thread, fn func(bench.FGenChannel) error) error {
+		return use(thread, inst, func(fg bench.FGen) error {
+			ch, err := fg.Channel(i)
+			if err != nil {
+				return err
+			}
+			return fn(ch)
+		})
+	}
+	getFloat := func(get func(bench.FGenChannel) (float64, error)) builtin {
+		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			var v float64
+			err := do(thread, func(ch bench.FGenChannel) (err error) {
+				v, err = get(ch)
+				return err
+			})
+			return floatValue(v, err)
+		}
+	}
+	setFloat := func(set func(bench.FGenChannel, float64) error) builtin {
+		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var v number
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &v); err != nil {
+				return nil, err
+			}
+			return noneValue(do(thread, func(ch bench.FGenChannel) error { return set(ch, float64(v)) }))
+		}
+	}
+
+	o.add("configure(waveform, amplitude, offset, frequency, phase = 0)",
+		"set a standard waveform, e.g., \"Sine\" (Vpp, V, Hz, degrees)",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				name                     string
+				amp, offset, freq, phase number
+			)
+			err := starlark.UnpackArgs(b.Name(), args, kwargs,
+				"waveform", &name, "amplitude", &amp, "offset", &offset, "frequency", &freq, "phase?", &phase)
+			if err != nil {
+				return nil, err
+			}
+			wave, err := bench.ParseEnum[fgen.StandardWaveform](name)
+			if err != nil {
+				return nil, err
+			}
+			return noneValue(do(thread, func(ch bench.FGenChannel) error {
+				return ch.ConfigureStandardWaveform(
+					wave, float64(amp), float64(offset), float64(freq), float64(phase))
+			}))
+		})
+	o.add("set_amplitude(vpp)", "set the amplitude (Vpp)", setFloat(bench.FGenChannel.SetAmplitude))
+	o.add("amplitude()", "amplitude (Vpp)", getFloat(bench.FGenChannel.Amplitude))
+	o.add("set_offset(v)", "set the DC offset (V)", setFloat(bench.FGenChannel.SetDCOffset))
+	o.add("offset()", "DC offset (V)", getFloat(bench.FGenChannel.DCOffset))
+	o.add("set_frequency(hz)", "set the frequency (Hz)", setFloat(bench.FGenChannel.SetFrequency))
+	o.add("frequency()", "frequency (Hz)", getFloat(bench.FGenChannel.Frequency))
+	setEnabled := func(ctx context.Context, on bool) error {
+		return useContext(ctx, inst, func(fg bench.FGen) error {
+			ch, err := fg.Channel(i)
+			if err != nil {
+				return err
+			}
+			if on {
+				return ch.EnableOutput()
+			}
+			return ch.DisableOutput()
+		})
+	}
+	o.add("enable_output()", "turn the output on", setOutput(o.name, true, setEnabled))
+	o.add("disable_output()", "turn the output off", setOutput(o.name, false, setEnabled))
+	o.add("output_enabled()", "whether the output is on",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			var on bool
+			err := do(thread, func(ch bench.FGenChannel) (err error) {
+				on, err = ch.OutputEnabled()
+				return err
+			})
+			return boolValue(on, err)
+		})
+	return o
+}
+
+func newSwtch(inst *bench.Instrument) *object {
+	o := newInstrument(inst)
+	connect := func(fn func(sw bench.Swtch, ch1, ch2 string) error) builtin {
+		return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var ch1, ch2 string
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &ch1, &ch2); err != nil {
+				return nil, err
+			}
+			return noneValue(use(thread, inst, func(sw bench.Swtch) error { return fn(sw, ch1, ch2) }))
+		}
+	}
+	o.add("connect(ch1, ch2)", "connect two channels", connect(bench.Swtch.Connect))
+	o.add("disconnect(ch1, ch2)", "disconnect two channels", connect(bench.Swtch.Disconnect))
+	o.add("disconnect_all()", "open every connection",
+		func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 0); err != nil {
+				return nil, err
+			}
+			return noneValue(use(thread, inst, func(sw bench.Swtch) error { return sw.DisconnectAll() }))
+		})
+	return o
+}
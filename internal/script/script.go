@@ -0,0 +1,388 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package script runs bench procedures written in Starlark, a dialect of
+// Python, so test engineers can write them without recompiling Go. The
+// instruments of a bench are available as objects with the IVI methods used
+// in the examples:
+//
+//	psu = dcpwr("psu").channel("P6V")
+//	psu.configure(voltage = 5.0, current_limit = 0.5)
+//	psu.enable_output()
+//	sleep("500ms")
+//	check("5 V rail", psu.measure_voltage(), nominal = 5, tolerance = "2%", unit = "V")
+//
+//	meter = dmm("dmm")
+//	meter.configure("DCVolts")
+//	require(meter.read() < 0.01, "DUT output should be off")
+//
+// The predeclared functions are:
+//
+//	dcpwr(name)   the named DC power supply
+//	dmm(name)     the named DMM
+//	fgen(name)    the named function generator
+//	swtch(name)   the named switch matrix
+//	sleep(d)      wait for d seconds or a duration such as "500ms"
+//	check(name, value, min = None, max = None, unit = "")
+//	check(name, value, nominal = n, tolerance = t, unit = "")
+//	              record a step that passes if min <= value <= max, or
+//	              within nominal ± tolerance, given as a value or a
+//	              percentage such as "2%", and return whether it passed;
+//	              the procedure continues either way
+//	require(cond, msg = "")
+//	              stop the procedure if cond is false
+//
+// If a procedure stops early, e.g., on a failed require or an instrument
+// error, the outputs it turned on and left on are turned off.
+//
+// Every instrument also has reset() and identity(), which returns a dict of
+// its manufacturer, model, serial number, and firmware revision. Call
+// help(object) to print the methods of an object.
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gotmc/ivi-examples/internal/bench"
+	"github.com/gotmc/ivi-examples/internal/measure"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// Step is the outcome of one check in a procedure.
+type Step struct {
+	Name  string    `json:"name"`
+	Value float64   `json:"value"`
+	Min   *float64  `json:"min,omitempty"`
+	Max   *float64  `json:"max,omitempty"`
+	Unit  string    `json:"unit,omitempty"`
+	Pass  bool      `json:"pass"`
+	Time  time.Time `json:"time"`
+}
+
+// Result is the outcome of running a procedure.
+type Result struct {
+	File     string        `json:"file"`
+	Steps    []Step        `json:"steps"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	// Err is the error that stopped the procedure, e.g., a failed require
+	// or an instrument error, or nil if it ran to the end.
+	Err error `json:"-"`
+}
+
+// Passed reports whether the procedure ran to the end with every step
+// passing.
+func (r *Result) Passed() bool {
+	if r.Err != nil {
+		return false
+	}
+	for _, s := range r.Steps {
+		if !s.Pass {
+			return false
+		}
+	}
+	return true
+}
+
+// Runner runs procedures against the instruments of a bench.
+type Runner struct {
+	bench  *bench.Bench
+	logger *log.Logger
+}
+
+// Option configures a Runner.
+type Option func(*Runner)
+
+// WithLogger sets the logger used for the output of print. The default is
+// the standard logger.
+func WithLogger(l *log.Logger) Option { return func(r *Runner) { r.logger = l } }
+
+// New creates a Runner using the instruments of b.
+func New(b *bench.Bench, opts ...Option) *Runner {
+	r := Runner{bench: b, logger: log.Default()}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// outputOffTimeout bounds turning off the outputs left on by a procedure that
+// stopped early.
+const outputOffTimeout = 10 * time.Second
+
+// Thread locals.
+const (
+	ctxKey     = "context"
+	resultKey  = "result"
+	outputsKey = "outputs"
+)
+
+// enabledOutputs are the outputs a procedure has turned on and not yet off,
+// in the order they were turned on.
+type enabledOutputs struct {
+	names []string
+	off   map[string]func(context.Context) error
+}
+
+// set records that the named output was turned on or off.
+func (e *enabledOutputs) set(name string, on bool, off func(context.Context) error) {
+	_, ok := e.off[name]
+	switch {
+	case on && !ok:
+		e.names = append(e.names, name)
+		e.off[name] = off
+	case !on && ok:
+		e.names = slices.DeleteFunc(e.names, func(n string) bool { return n == name })
+		delete(e.off, name)
+	}
+}
+
+// disable turns off the outputs, the last turned on first.
+func (e *enabledOutputs) disable(ctx context.Context) error {
+	var errs []error
+	for _, name := range slices.Backward(e.names) {
+		if err := e.off[name](ctx); err != nil {
+			errs = append(errs, fmt.Errorf("error turning off %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run runs the procedure in filename. If src isn't nil, it's used as the
+// source instead of reading the file; see starlark.ExecFileOptions. The
+// procedure is cancelled when ctx is done. If the procedure stops early, the
+// outputs it left on are turned off.
+func (r *Runner) Run(ctx context.Context, filename string, src any) *Result {
+	res := Result{File: filename, Steps: []Step{}, Start: time.Now()}
+	outputs := enabledOutputs{off: make(map[string]func(context.Context) error)}
+	thread := &starlark.Thread{
+		Name:  filename,
+		Print: func(_ *starlark.Thread, msg string) { r.logger.Printf("%s: %s", filename, msg) },
+	}
+	thread.SetLocal(ctxKey, ctx)
+	thread.SetLocal(resultKey, &res)
+	thread.SetLocal(outputsKey, &outputs)
+	stop := context.AfterFunc(ctx, func() { thread.Cancel(context.Cause(ctx).Error()) })
+	defer stop()
+
+	_, err := starlark.ExecFileOptions(&syntax.FileOptions{}, thread, filename, src, r.predeclared())
+	if err != nil {
+		var evalErr *starlark.EvalError
+		if errors.As(err, &evalErr) {
+			err = errors.New(evalErr.Backtrace())
+		}
+		// Turn the outputs off even if the procedure was cancelled.
+		offCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), outputOffTimeout)
+		defer cancel()
+		res.Err = errors.Join(err, outputs.disable(offCtx))
+	}
+	res.Duration = time.Since(res.Start)
+	return &res
+}
+
+func (r *Runner) predeclared() starlark.StringDict {
+	return starlark.StringDict{
+		"dcpwr":   starlark.NewBuiltin("dcpwr", r.instrument(bench.ClassDCPwr, newDCPwr)),
+		"dmm":     starlark.NewBuiltin("dmm", r.instrument(bench.ClassDMM, newDMM)),
+		"fgen":    starlark.NewBuiltin("fgen", r.instrument(bench.ClassFGen, newFGen)),
+		"swtch":   starlark.NewBuiltin("swtch", r.instrument(bench.ClassSwtch, newSwtch)),
+		"sleep":   starlark.NewBuiltin("sleep", sleep),
+		"check":   starlark.NewBuiltin("check", check),
+		"require": starlark.NewBuiltin("require", require),
+		"help":    starlark.NewBuiltin("help", help),
+	}
+}
+
+// instrument returns the builtin looking up an instrument of a class by
+// name.
+func (r *Runner) instrument(class bench.Class, wrap func(*bench.Instrument) *object) builtin {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var name string
+		if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &name); err != nil {
+			return nil, err
+		}
+		inst, ok := r.bench.Instrument(name)
+		if !ok {
+			return nil, fmt.Errorf("no instrument named %q", name)
+		}
+		if inst.Class != class {
+			return nil, fmt.Errorf("%s is a %s", name, inst.Class)
+		}
+		return wrap(inst), nil
+	}
+}
+
+func threadContext(thread *starlark.Thread) context.Context {
+	return thread.Local(ctxKey).(context.Context)
+}
+
+func sleep(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var d duration
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &d); err != nil {
+		return nil, err
+	}
+	t := time.NewTimer(time.Duration(d))
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return starlark.None, nil
+	case <-threadContext(thread).Done():
+		return nil, context.Cause(threadContext(thread))
+	}
+}
+
+func check(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		name            string
+		value           number
+		lo, hi, nominal optionalFloat
+		tol             tolerance
+		unit            string
+	)
+	err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"name", &name, "value", &value, "min?", &lo, "max?", &hi,
+		"nominal?", &nominal, "tolerance?", &tol, "unit?", &unit)
+	if err != nil {
+		return nil, err
+	}
+	limit := measure.Limit{Min: lo.v, Max: hi.v}
+	switch {
+	case nominal.v != nil && (lo.v != nil || hi.v != nil):
+		return nil, errors.New("give either nominal and tolerance or min and max")
+	case nominal.v != nil && !tol.set:
+		return nil, errors.New("nominal requires a tolerance")
+	case nominal.v != nil && tol.percent:
+		limit = measure.TolerancePercent(*nominal.v, tol.v)
+	case nominal.v != nil:
+		limit = measure.Tolerance(*nominal.v, tol.v)
+	case tol.set:
+		return nil, errors.New("tolerance requires a nominal value")
+	}
+
+	r := measure.Check(name, measure.Measurement{Value: float64(value), Unit: measure.Unit(unit)}, limit)
+	res := thread.Local(resultKey).(*Result)
+	res.Steps = append(res.Steps, Step{
+		Name:  r.Name,
+		Value: r.Value,
+		Min:   r.Limit.Min,
+		Max:   r.Limit.Max,
+		Unit:  unit,
+		Pass:  r.Pass,
+		Time:  r.Time,
+	})
+	return starlark.Bool(r.Pass), nil
+}
+
+func require(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		cond starlark.Value
+		msg  string
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "cond", &cond, "msg?", &msg); err != nil {
+		return nil, err
+	}
+	if cond.Truth() {
+		return starlark.None, nil
+	}
+	if msg == "" {
+		msg = cond.String()
+	}
+	return nil, fmt.Errorf("requirement failed: %s", msg)
+}
+
+func help(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var v starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &v); err != nil {
+		return nil, err
+	}
+	o, ok := v.(*object)
+	if !ok {
+		return nil, fmt.Errorf("no help for %s", v.Type())
+	}
+	thread.Print(thread, o.help())
+	return starlark.None, nil
+}
+
+// duration unpacks a number of seconds or a string such as "500ms".
+type duration time.Duration
+
+func (d *duration) Unpack(v starlark.Value) error {
+	if s, ok := starlark.AsString(v); ok {
+		t, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		*d = duration(t)
+		return nil
+	}
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return fmt.Errorf("got %s, want a number of seconds or a duration string", v.Type())
+	}
+	*d = duration(f * float64(time.Second))
+	return nil
+}
+
+// number unpacks an int or a float.
+type number float64
+
+func (n *number) Unpack(v starlark.Value) error {
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return fmt.Errorf("got %s, want a number", v.Type())
+	}
+	*n = number(f)
+	return nil
+}
+
+// tolerance unpacks an absolute tolerance or a percentage such as "2%".
+type tolerance struct {
+	v       float64
+	percent bool
+	set     bool
+}
+
+func (t *tolerance) Unpack(v starlark.Value) error {
+	t.set = true
+	if s, ok := starlark.AsString(v); ok {
+		pct, found := strings.CutSuffix(strings.TrimSpace(s), "%")
+		f, err := strconv.ParseFloat(strings.TrimSpace(pct), 64)
+		if !found || err != nil {
+			return fmt.Errorf("invalid tolerance %q, want a number or a percentage such as \"2%%\"", s)
+		}
+		t.v, t.percent = f, true
+		return nil
+	}
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return fmt.Errorf("got %s, want a number or a percentage", v.Type())
+	}
+	t.v = f
+	return nil
+}
+
+// optionalFloat unpacks a number or None.
+type optionalFloat struct{ v *float64 }
+
+func (o *optionalFloat) Unpack(v starlark.Value) error {
+	if v == starlark.None {
+		o.v = nil
+		return nil
+	}
+	f, ok := starlark.AsFloat(v)
+	if !ok {
+		return fmt.Errorf("got %s, want a number or None", v.Type())
+	}
+	o.v = &f
+	return nil
+}
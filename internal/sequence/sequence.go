@@ -0,0 +1,209 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package sequence brings DC power supply rails up in order and tears them
+// down in reverse order. Each rail is configured with its output off, then
+// enabled, and the next rail isn't started until the measured voltage is
+// within tolerance. The rails can be on different power supplies.
+package sequence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+)
+
+// ErrNotInTolerance is returned when a rail's measured voltage doesn't reach
+// its tolerance band in time.
+var ErrNotInTolerance = errors.New("voltage not in tolerance")
+
+// DefaultTolerance is the tolerance, relative to the voltage, used when a
+// Rail doesn't set one.
+const DefaultTolerance = 0.05
+
+// DefaultTimeout is the time a rail is given to come into tolerance when the
+// Rail doesn't set one.
+const DefaultTimeout = 2 * time.Second
+
+// Output is the DC power supply output driving a rail. The bench.DCPwrChannel
+// interface satisfies it.
+type Output interface {
+	SetVoltageLevel(v float64) error
+	SetCurrentLimit(a float64) error
+	ConfigureOVP(enabled bool, limit float64) error
+	SetOutputEnabled(b bool) error
+	MeasureVoltage() (float64, error)
+}
+
+// Rail is one supply voltage in a sequence.
+type Rail struct {
+	Name         string
+	Output       Output
+	Voltage      float64 // V
+	CurrentLimit float64 // A
+	// OVP is the over-voltage protection limit in volts. Zero leaves the
+	// output's OVP setting unchanged.
+	OVP float64
+	// Tolerance is the allowed difference in volts between the measured and
+	// programmed voltage. Zero means DefaultTolerance of the voltage.
+	Tolerance float64
+	// Timeout is how long the rail has to come into tolerance after its
+	// output is enabled. Zero means DefaultTimeout.
+	Timeout time.Duration
+	// Delay is the wait after the rail is up before starting the next one,
+	// and after it's turned off before turning off the previous one.
+	Delay time.Duration
+}
+
+func (r Rail) tolerance() float64 {
+	if r.Tolerance > 0 {
+		return r.Tolerance
+	}
+	return DefaultTolerance * math.Abs(r.Voltage)
+}
+
+func (r Rail) timeout() time.Duration {
+	if r.Timeout > 0 {
+		return r.Timeout
+	}
+	return DefaultTimeout
+}
+
+// Sequencer brings up and tears down a list of rails.
+type Sequencer struct {
+	rails  []Rail
+	poll   time.Duration
+	logger *log.Logger
+	up     int // number of rails Up has touched
+}
+
+// Option configures a Sequencer.
+type Option func(*Sequencer)
+
+// WithPollInterval sets the time between voltage measurements while waiting
+// for a rail to come into tolerance. The default is 100 ms.
+func WithPollInterval(d time.Duration) Option { return func(s *Sequencer) { s.poll = d } }
+
+// WithLogger sets the logger used to report progress. The default is the
+// standard logger.
+func WithLogger(l *log.Logger) Option { return func(s *Sequencer) { s.logger = l } }
+
+// New creates a Sequencer for the rails in power-up order.
+func New(rails []Rail, opts ...Option) *Sequencer {
+	s := Sequencer{
+		rails:  rails,
+		poll:   100 * time.Millisecond,
+		logger: log.Default(),
+	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+	return &s
+}
+
+// Up brings the rails up in order. If a rail fails to come up or ctx is done
+// first, the rails already touched, including the failing one, are torn down
+// in reverse order and the errors of both are returned.
+func (s *Sequencer) Up(ctx context.Context) error {
+	for _, r := range s.rails {
+		err := ctx.Err()
+		if err == nil {
+			s.up++
+			err = s.bringUp(ctx, r)
+		}
+		if err != nil {
+			// Tear down even though ctx may be done.
+			down := s.Down(context.WithoutCancel(ctx))
+			return errors.Join(fmt.Errorf("rail %s: %w", r.Name, err), down)
+		}
+	}
+	return nil
+}
+
+func (s *Sequencer) bringUp(ctx context.Context, r Rail) error {
+	s.logger.Printf("Bringing up %s to %g V", r.Name, r.Voltage)
+	if err := r.Output.SetOutputEnabled(false); err != nil {
+		return fmt.Errorf("error disabling output: %w", err)
+	}
+	if r.OVP > 0 {
+		if err := r.Output.ConfigureOVP(true, r.OVP); err != nil {
+			return fmt.Errorf("error configuring OVP: %w", err)
+		}
+	}
+	if err := r.Output.SetCurrentLimit(r.CurrentLimit); err != nil {
+		return fmt.Errorf("error setting current limit: %w", err)
+	}
+	if err := r.Output.SetVoltageLevel(r.Voltage); err != nil {
+		return fmt.Errorf("error setting voltage level: %w", err)
+	}
+	if err := r.Output.SetOutputEnabled(true); err != nil {
+		return fmt.Errorf("error enabling output: %w", err)
+	}
+	if err := s.settle(ctx, r); err != nil {
+		return err
+	}
+	return sleep(ctx, r.Delay)
+}
+
+// settle waits for the measured voltage to come into tolerance.
+func (s *Sequencer) settle(ctx context.Context, r Rail) error {
+	start := time.Now()
+	deadline := start.Add(r.timeout())
+	tol := r.tolerance()
+	for {
+		v, err := r.Output.MeasureVoltage()
+		if err != nil {
+			return fmt.Errorf("error measuring voltage: %w", err)
+		}
+		if math.Abs(v-r.Voltage) <= tol {
+			s.logger.Printf("%s up at %.4g V after %s", r.Name, v, time.Since(start).Round(time.Millisecond))
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("%w: measured %.4g V after %s, want %g ± %.4g V",
+				ErrNotInTolerance, v, r.timeout(), r.Voltage, tol)
+		}
+		if err := sleep(ctx, s.poll); err != nil {
+			return err
+		}
+	}
+}
+
+// Down turns off the outputs of the rails touched by Up in reverse order,
+// waiting each rail's delay in between. Every output is turned off even if
+// some fail or ctx is done, in which case the delays are skipped.
+func (s *Sequencer) Down(ctx context.Context) error {
+	var errs []error
+	for i := s.up - 1; i >= 0; i-- {
+		r := s.rails[i]
+		s.logger.Printf("Turning off %s", r.Name)
+		if err := r.Output.SetOutputEnabled(false); err != nil {
+			errs = append(errs, fmt.Errorf("rail %s: error disabling output: %w", r.Name, err))
+		}
+		if i > 0 {
+			_ = sleep(ctx, r.Delay)
+		}
+	}
+	s.up = 0
+	return errors.Join(errs...)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+package tui
+
+import (
+	"math"
+	"slices"
+	"strings"
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders the last width values as a line of block characters
+// scaled between their minimum and maximum. A constant series is drawn at
+// mid height.
+func Sparkline(values []float64, width int) string {
+	if width <= 0 || len(values) == 0 {
+		return ""
+	}
+	values = values[max(len(values)-width, 0):]
+	lo, hi := slices.Min(values), slices.Max(values)
+	var b strings.Builder
+	for _, v := range values {
+		i := len(sparks) / 2
+		if hi > lo {
+			i = int(math.Round((v - lo) / (hi - lo) * float64(len(sparks)-1)))
+		}
+		b.WriteRune(sparks[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2017-2026 The ivi-examples developers. All rights reserved.
+// Project site: https://github.com/gotmc/ivi-examples
+// Use of this source code is governed by a MIT-style license that
+// can be found in the LICENSE.txt file for the project.
+
+// Package tui holds the pieces shared by the terminal dashboards built with
+// Bubble Tea: the styles, the key help line, a sparkline, and a prompt for
+// entering numbers such as setpoints.
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Styles used by the dashboards.
+var (
+	Title    = lipgloss.NewStyle().Bold(true)
+	Header   = lipgloss.NewStyle().Bold(true).Underline(true)
+	Selected = lipgloss.NewStyle().Reverse(true)
+	Faint    = lipgloss.NewStyle().Faint(true)
+	On       = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10"))
+	Warn     = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11"))
+	Error    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+// Binding is a key and what it does.
+type Binding struct {
+	Key  string
+	Desc string
+}
+
+// Help renders the key bindings as a single line, e.g., "q quit • o output".
+func Help(bindings ...Binding) string {
+	parts := make([]string, len(bindings))
+	for i, b := range bindings {
+		parts[i] = Title.Render(b.Key) + " " + Faint.Render(b.Desc)
+	}
+	return strings.Join(parts, Faint.Render(" • "))
+}
+
+// Prompt reads a number, e.g., a new setpoint. While it's active, the model
+// passes its messages to Update instead of handling the keys itself.
+type Prompt struct {
+	input  textinput.Model
+	submit func(float64) tea.Cmd
+	err    error
+}
+
+// Open activates the prompt showing the current value. When Enter is
+// pressed, submit is called with the number entered.
+func (p *Prompt) Open(label string, value float64, submit func(float64) tea.Cmd) tea.Cmd {
+	p.input = textinput.New()
+	p.input.Prompt = label + ": "
+	p.input.SetValue(strconv.FormatFloat(value, 'g', -1, 64))
+	p.input.CursorEnd()
+	p.submit = submit
+	p.err = nil
+	return p.input.Focus()
+}
+
+// Active reports whether the prompt is reading a number.
+func (p *Prompt) Active() bool { return p.submit != nil }
+
+// Update handles a message while the prompt is active. Enter submits the
+// number and Esc cancels the prompt.
+func (p *Prompt) Update(msg tea.Msg) tea.Cmd {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.Type {
+		case tea.KeyEsc:
+			p.submit = nil
+			return nil
+		case tea.KeyEnter:
+			text := strings.TrimSpace(p.input.Value())
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				p.err = fmt.Errorf("invalid number %q", text)
+				return nil
+			}
+			submit := p.submit
+			p.submit = nil
+			return submit(v)
+		}
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return cmd
+}
+
+// View renders the prompt, or nothing if it isn't active.
+func (p *Prompt) View() string {
+	if !p.Active() {
+		return ""
+	}
+	if p.err != nil {
+		return p.input.View() + "  " + Error.Render(p.err.Error())
+	}
+	return p.input.View()
+}